@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Conflux-Chain/go-conflux-sdk/types"
@@ -16,12 +18,21 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// ErrAccountAlreadyExists is returned when importing an account which already exists in keystore directory.
+var ErrAccountAlreadyExists = errors.New("account already exists")
+
 // AccountManager manages Conflux accounts.
+//
+// AccountManager is safe for concurrent use by multiple goroutines, so that transactions of
+// different accounts could be signed in parallel. The underlying keystore is thread-safe itself,
+// and the address dictionary is guarded by an internal lock.
 type AccountManager struct {
 	ks            *keystore.KeyStore
 	cfxAddressDic map[string]*accounts.Account
+	dicMu         sync.RWMutex
 }
 
 // NewAccountManager creates an instance of AccountManager
@@ -49,13 +60,12 @@ func (m *AccountManager) Create(passphrase string) (types.Address, error) {
 		return "", types.WrapError(err, msg)
 	}
 
-	cfxAddress := utils.ToCfxGeneralAddress(account.Address)
-	m.cfxAddressDic[string(cfxAddress)] = &account
+	cfxAddress := m.addAccount(account)
 	return cfxAddress, nil
 }
 
 // Import imports account from external key file to keystore directory.
-// Returns error if the account already exists.
+// Returns ErrAccountAlreadyExists if the account already exists.
 func (m *AccountManager) Import(keyFile, passphrase, newPassphrase string) (types.Address, error) {
 	keyJSON, err := ioutil.ReadFile(keyFile)
 	if err != nil {
@@ -70,7 +80,7 @@ func (m *AccountManager) Import(keyFile, passphrase, newPassphrase string) (type
 	}
 
 	if m.ks.HasAddress(key.Address) {
-		return "", fmt.Errorf("account already exists: %s", hexutil.Encode(key.Address.Bytes()))
+		return "", types.WrapError(ErrAccountAlreadyExists, hexutil.Encode(key.Address.Bytes()))
 	}
 
 	account, err := m.ks.Import(keyJSON, passphrase, newPassphrase)
@@ -79,18 +89,74 @@ func (m *AccountManager) Import(keyFile, passphrase, newPassphrase string) (type
 		return "", types.WrapError(err, msg)
 	}
 
-	cfxAddress := utils.ToCfxGeneralAddress(account.Address)
-	m.cfxAddressDic[string(cfxAddress)] = &account
+	cfxAddress := m.addAccount(account)
+	return cfxAddress, nil
+}
+
+// ImportFromPrivateKey imports account from the HEX private key with or without 0x prefix, and puts the keystore
+// file encrypted by passphrase into keystore directory.
+//
+// If the account already exists, its address is returned along with ErrAccountAlreadyExists, and the existing
+// keystore file is kept unchanged, so it is still encrypted by the original passphrase.
+func (m *AccountManager) ImportFromPrivateKey(hexKey, passphrase string) (types.Address, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimPrefix(hexKey, "0x"), "0X"))
+	if err != nil {
+		return "", types.WrapError(err, "parse private key error")
+	}
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	if m.ks.HasAddress(address) {
+		return utils.ToCfxGeneralAddress(address), types.WrapError(ErrAccountAlreadyExists, hexutil.Encode(address.Bytes()))
+	}
+
+	account, err := m.ks.ImportECDSA(key, passphrase)
+	if err != nil {
+		msg := fmt.Sprintf("import account %v by private key error", hexutil.Encode(address.Bytes()))
+		return "", types.WrapError(err, msg)
+	}
+
+	cfxAddress := m.addAccount(account)
 	return cfxAddress, nil
 }
 
+// ExportPrivateKey decrypts the keystore file of specified account by passphrase and returns the HEX private key
+// with 0x prefix, which is useful for backup. Keep the private key in secret, anyone who has it controls the account.
+func (m *AccountManager) ExportPrivateKey(address types.Address, passphrase string) (string, error) {
+	account := m.account(address)
+	if account == nil {
+		return "", types.NewAccountNotFoundError(address)
+	}
+
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		msg := fmt.Sprintf("read keystore file of account %v error", address)
+		return "", types.WrapError(err, msg)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		msg := fmt.Sprintf("decrypt keystore file of account %v error", address)
+		return "", types.WrapError(err, msg)
+	}
+
+	return hexutil.Encode(crypto.FromECDSA(key.PrivateKey)), nil
+}
+
 // Delete deletes the specified account and remove the keystore file from keystore directory.
 func (m *AccountManager) Delete(address types.Address, passphrase string) error {
 	account := m.account(address)
 	if account == nil {
 		return nil
 	}
-	return m.ks.Delete(*account, passphrase)
+
+	if err := m.ks.Delete(*account, passphrase); err != nil {
+		return err
+	}
+
+	m.dicMu.Lock()
+	delete(m.cfxAddressDic, string(address))
+	m.dicMu.Unlock()
+	return nil
 }
 
 // Update updates the passphrase of specified account.
@@ -102,7 +168,8 @@ func (m *AccountManager) Update(address types.Address, passphrase, newPassphrase
 	return m.ks.Update(*account, passphrase, newPassphrase)
 }
 
-// List lists all accounts in keystore directory.
+// List lists all accounts in keystore directory, ordered by the keystore file path. The file name of account
+// created or imported by AccountManager starts with its UTC creation time, so they are listed in creation order.
 func (m *AccountManager) List() []types.Address {
 	result := make([]types.Address, 0)
 
@@ -125,10 +192,24 @@ func (m *AccountManager) GetDefault() (*types.Address, error) {
 }
 
 func (m *AccountManager) account(address types.Address) *accounts.Account {
+	m.dicMu.RLock()
+	defer m.dicMu.RUnlock()
+
 	realAccount := m.cfxAddressDic[string(address)]
 	return realAccount
 }
 
+// addAccount adds account to the address dictionary and returns its address.
+func (m *AccountManager) addAccount(account accounts.Account) types.Address {
+	cfxAddress := utils.ToCfxGeneralAddress(account.Address)
+
+	m.dicMu.Lock()
+	defer m.dicMu.Unlock()
+
+	m.cfxAddressDic[string(cfxAddress)] = &account
+	return cfxAddress
+}
+
 // Unlock unlocks the specified account indefinitely.
 func (m *AccountManager) Unlock(address types.Address, passphrase string) error {
 	account := m.account(address)
@@ -205,6 +286,7 @@ func (m *AccountManager) SignTransaction(tx types.UnsignedTransaction) ([]byte,
 }
 
 // SignAndEcodeTransactionWithPassphrase signs tx with given passphrase and return its RLP encoded data.
+// The from account need not be unlocked, its key is decrypted only for signing and the account is not left unlocked.
 func (m *AccountManager) SignAndEcodeTransactionWithPassphrase(tx types.UnsignedTransaction, passphrase string) ([]byte, error) {
 	// tx.ApplyDefault()
 	if tx.From == nil {
@@ -237,7 +319,8 @@ func (m *AccountManager) SignAndEcodeTransactionWithPassphrase(tx types.Unsigned
 	return encoded, nil
 }
 
-// SignTransactionWithPassphrase signs tx with given passphrase and returns a transction with signature
+// SignTransactionWithPassphrase signs tx with given passphrase and returns a transction with signature.
+// The from account need not be unlocked, its key is decrypted only for signing and the account is not left unlocked.
 func (m *AccountManager) SignTransactionWithPassphrase(tx types.UnsignedTransaction, passphrase string) (*types.SignedTransaction, error) {
 	// tx.ApplyDefault()
 	if tx.From == nil {
@@ -299,3 +382,21 @@ func (m *AccountManager) Sign(tx types.UnsignedTransaction, passphrase string) (
 	s = sig[32:64]
 	return v, r, s, nil
 }
+
+// SignMessage signs message by personal sign with the specified unlocked account,
+// and returns the signature in [R || S || V] format.
+// Use utils.EcRecover to recover the signer address from the signature.
+func (m *AccountManager) SignMessage(address types.Address, message []byte) ([]byte, error) {
+	account := m.account(address)
+	if account == nil {
+		return nil, types.NewAccountNotFoundError(address)
+	}
+
+	hash := utils.PersonalMessageHash(message)
+	sig, err := m.ks.SignHash(*account, hash)
+	if err != nil {
+		msg := fmt.Sprintf("sign message hash {%+x} by account %+v error", hash, account)
+		return nil, types.WrapError(err, msg)
+	}
+	return sig, nil
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/Conflux-Chain/go-conflux-sdk/utils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// run with -race to detect data races of AccountManager
+func TestAccountManagerConcurrentSign(t *testing.T) {
+
+	Convey("Subject: Sign transactions concurrently", t, func() {
+		am, first, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		second, err := am.Create("password")
+		So(err, ShouldBeNil)
+		So(am.Unlock(second, "password"), ShouldBeNil)
+
+		Convey("When sign transactions of different accounts in parallel while creating account", func() {
+			senders := []types.Address{first, second}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 41)
+			for i := 0; i < 40; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					tx := types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+					tx.From = &senders[i%len(senders)]
+					tx.Nonce = types.NewBigInt(int64(i))
+					tx.ChainID = types.NewBigInt(1)
+					tx.GasPrice = types.NewBigInt(1)
+					tx.EpochHeight = types.NewBigInt(100)
+					tx.Gas = types.NewBigInt(21000)
+					tx.StorageLimit = types.NewBigInt(0)
+					tx.Value = types.NewBigInt(1)
+					_, err := am.SignTransaction(tx)
+					errs <- err
+				}(i)
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := am.Create("password")
+				errs <- err
+			}()
+
+			wg.Wait()
+			close(errs)
+
+			Convey("All transactions are signed without error", func() {
+				for err := range errs {
+					So(err, ShouldBeNil)
+				}
+				So(len(am.List()), ShouldEqual, 3)
+			})
+		})
+	})
+}
+
+func TestAccountManagerSignMessage(t *testing.T) {
+
+	Convey("Subject: Sign message by account manager", t, func() {
+		am, address, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		Convey("When sign message by an unlocked account", func() {
+			message := []byte("login nonce: 12345")
+			sig, err := am.SignMessage(address, message)
+
+			Convey("The signer could be recovered from signature", func() {
+				So(err, ShouldBeNil)
+				recovered, err := utils.EcRecover(message, sig)
+				So(err, ShouldBeNil)
+				So(recovered, ShouldEqual, address)
+			})
+		})
+
+		Convey("When sign message by an unknown account", func() {
+			_, err := am.SignMessage(types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377d"), []byte("hello"))
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAccountManagerImportExportPrivateKey(t *testing.T) {
+
+	Convey("Subject: Import and export private key", t, func() {
+		keydir, err := ioutil.TempDir("", "keystore")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(keydir)
+
+		am := NewAccountManager(keydir)
+		hexKey := "0x0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+		Convey("When import the private key", func() {
+			address, err := am.ImportFromPrivateKey(hexKey, "password")
+
+			Convey("The account is listed and could be exported", func() {
+				So(err, ShouldBeNil)
+				So(address, ShouldEqual, types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(am.List(), ShouldResemble, []types.Address{address})
+
+				exported, err := am.ExportPrivateKey(address, "password")
+				So(err, ShouldBeNil)
+				So(exported, ShouldEqual, hexKey)
+
+				_, err = am.ExportPrivateKey(address, "wrong")
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Import again returns the address with ErrAccountAlreadyExists", func() {
+				again, err := am.ImportFromPrivateKey(hexKey[2:], "another")
+				So(errors.Is(err, ErrAccountAlreadyExists), ShouldBeTrue)
+				So(again, ShouldEqual, address)
+				So(len(am.List()), ShouldEqual, 1)
+			})
+		})
+
+		Convey("Return error for invalid private key", func() {
+			_, err := am.ImportFromPrivateKey("0x1234", "password")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Default values of Backoff fields.
+const (
+	defaultBackoffBase       = time.Second
+	defaultBackoffMax        = 30 * time.Second
+	defaultBackoffMultiplier = 2
+)
+
+// Backoff is an exponential backoff with jitter for the interval between retries, it is an alternative to
+// the constant retry interval, which waits longer when conflux node keeps failing and spreads the retries
+// of many clients so that they don't hit a recovering node at the same time.
+type Backoff struct {
+	// Base is the interval before the first retry, default value is 1 second.
+	Base time.Duration
+	// Max is the upper bound of interval, default value is 30 seconds.
+	Max time.Duration
+	// Multiplier is the factor by which the interval grows after each retry, default value is 2.
+	Multiplier float64
+	// Jitter is the fraction of interval randomized in both directions, which should be in [0, 1],
+	// e.g. 0.2 means the interval is randomized in [0.8, 1.2] times of the exponential interval.
+	Jitter float64
+}
+
+// Delay returns the interval before the retry of attempt, which starts from 0 for the first retry.
+func (b *Backoff) Delay(attempt int) time.Duration {
+	base, max, multiplier := b.Base, b.Max, b.Multiplier
+	if base <= 0 {
+		base = defaultBackoffBase
+	}
+	if max <= 0 {
+		max = defaultBackoffMax
+	}
+	if multiplier < 1 {
+		multiplier = defaultBackoffMultiplier
+	}
+
+	delay := math.Min(float64(base)*math.Pow(multiplier, float64(attempt)), float64(max))
+
+	if jitter := math.Min(b.Jitter, 1); jitter > 0 {
+		delay *= 1 - jitter + 2*jitter*rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
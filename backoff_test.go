@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBackoff(t *testing.T) {
+
+	Convey("Subject: Exponential backoff between retries", t, func() {
+
+		Convey("Given a backoff without jitter", func() {
+			backoff := &Backoff{Base: 100 * time.Millisecond, Max: time.Second, Multiplier: 3}
+
+			Convey("The interval grows exponentially and is capped by max", func() {
+				So(backoff.Delay(0), ShouldEqual, 100*time.Millisecond)
+				So(backoff.Delay(1), ShouldEqual, 300*time.Millisecond)
+				So(backoff.Delay(2), ShouldEqual, 900*time.Millisecond)
+				So(backoff.Delay(3), ShouldEqual, time.Second)
+				So(backoff.Delay(1000), ShouldEqual, time.Second)
+			})
+		})
+
+		Convey("Given a zero backoff", func() {
+			backoff := &Backoff{}
+
+			Convey("The default values are used", func() {
+				So(backoff.Delay(0), ShouldEqual, time.Second)
+				So(backoff.Delay(1), ShouldEqual, 2*time.Second)
+				So(backoff.Delay(10), ShouldEqual, 30*time.Second)
+			})
+		})
+
+		Convey("Given a backoff with jitter", func() {
+			backoff := &Backoff{Base: time.Second, Jitter: 0.2}
+
+			Convey("The interval is randomized within the jitter range", func() {
+				for i := 0; i < 100; i++ {
+					delay := backoff.Delay(1)
+					So(delay, ShouldBeBetweenOrEqual, 1600*time.Millisecond, 2400*time.Millisecond)
+				}
+			})
+		})
+
+		Convey("Given a client retries 3 times with backoff and fake clock", func() {
+			requester := newFlakyRequester(3)
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 3,
+				interval:   time.Hour,
+				backoff:    &Backoff{Base: time.Second, Max: 3 * time.Second},
+			})
+			clock := newFakeClock()
+			client.SetClock(clock)
+
+			Convey("When call a node fails 3 times", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Wait the backoff intervals instead of the constant interval", func() {
+					So(err, ShouldBeNil)
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second})
+				})
+			})
+
+			Convey("When call with retry interval override", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{RetryCount: 3, RetryInterval: time.Minute}, "cfx_gasPrice")
+
+				Convey("Wait the constant interval of option", func() {
+					So(err, ShouldBeNil)
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Minute, time.Minute, time.Minute})
+				})
+			})
+		})
+	})
+}
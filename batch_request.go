@@ -0,0 +1,80 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// ErrBatchIndexOutOfRange is returned by BatchRequest.Error if no request is added at the index.
+var ErrBatchIndexOutOfRange = errors.New("index out of range of batch requests")
+
+// BatchRequest collects heterogeneous rpc requests and sends them to conflux node in one round trip.
+// You can conveniently create it by Client.NewBatchRequest.
+type BatchRequest struct {
+	client ClientOperator
+	elems  []rpc.BatchElem
+}
+
+// NewBatchRequest creates an empty BatchRequest which is sent by client.
+func (client *Client) NewBatchRequest() *BatchRequest {
+	return &BatchRequest{client: client}
+}
+
+// Add appends a request of method with args, the result is unmarshaled into resultPtr when executed.
+// It returns the index of request, which is used to get the error of request by Error.
+func (b *BatchRequest) Add(method string, resultPtr interface{}, args ...interface{}) int {
+	b.elems = append(b.elems, rpc.BatchElem{
+		Method: method,
+		Args:   args,
+		Result: resultPtr,
+	})
+	return len(b.elems) - 1
+}
+
+// Len returns the number of requests added.
+func (b *BatchRequest) Len() int {
+	return len(b.elems)
+}
+
+// Execute sends all requests as a single batch and waits for the responses of all of them.
+//
+// It returns the I/O error if the batch failed to send, otherwise returns types.BatchElemErrors keyed by the index
+// of failed requests if any of them failed, the results of other requests are filled as usual.
+func (b *BatchRequest) Execute() error {
+	if len(b.elems) == 0 {
+		return nil
+	}
+
+	if err := b.client.BatchCallRPC(b.elems); err != nil {
+		return types.WrapError(err, "batch call rpc error")
+	}
+
+	errs := make(types.BatchElemErrors)
+	for i, elem := range b.elems {
+		if elem.Error != nil {
+			msg := fmt.Sprintf("rpc %v with args %+v error", elem.Method, elem.Args)
+			errs[i] = types.WrapError(elem.Error, msg)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Error returns the error of request at index after executed, or nil if the request succeeded.
+// ErrBatchIndexOutOfRange is returned if index is not returned by Add.
+func (b *BatchRequest) Error(index int) error {
+	if index < 0 || index >= len(b.elems) {
+		msg := fmt.Sprintf("get error of request %v in %v requests", index, len(b.elems))
+		return types.WrapError(ErrBatchIndexOutOfRange, msg)
+	}
+	return b.elems[index].Error
+}
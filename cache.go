@@ -0,0 +1,91 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"math/big"
+	"sync"
+	"time"
+)
+
+// defaultCacheTTL is the default time to live of cached values if caching is enabled with zero ttl.
+const defaultCacheTTL = 3 * time.Second
+
+// valueCache caches big integer values for a short time, it is safe for concurrent use.
+type valueCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value    *big.Int
+	expireAt time.Time
+}
+
+func newValueCache(ttl time.Duration) *valueCache {
+	return &valueCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+// get returns a copy of the value cached by key if it is not expired at now.
+func (c *valueCache) get(key string, now time.Time) (*big.Int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expireAt) {
+		return nil, false
+	}
+	return new(big.Int).Set(entry.value), true
+}
+
+// set caches a copy of value by key, which expires after ttl since now.
+func (c *valueCache) set(key string, value *big.Int, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{new(big.Int).Set(value), now.Add(c.ttl)}
+}
+
+// cached returns the value cached by key, or loads it by load and caches it if not cached or expired.
+// The value is loaded directly if caching is not enabled.
+func (client *Client) cached(key string, load func() (*big.Int, error)) (*big.Int, error) {
+	client.cacheMu.RLock()
+	cache := client.cache
+	client.cacheMu.RUnlock()
+
+	if cache == nil {
+		return load()
+	}
+
+	clock := client.getClock()
+	if value, ok := cache.get(key, clock.Now()); ok {
+		return value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	cache.set(key, value, clock.Now())
+	return value, nil
+}
+
+// EnableCaching enables caching the results of GetGasPrice and GetEpochNumber(EpochLatestState) for ttl,
+// so that sending transactions in quick succession does not request them from node every time.
+// The default ttl of 3 seconds is used if ttl is 0, and caching is disabled if ttl is negative.
+func (client *Client) EnableCaching(ttl time.Duration) {
+	client.cacheMu.Lock()
+	defer client.cacheMu.Unlock()
+
+	switch {
+	case ttl < 0:
+		client.cache = nil
+	case ttl == 0:
+		client.cache = newValueCache(defaultCacheTTL)
+	default:
+		client.cache = newValueCache(ttl)
+	}
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newCachingRequester() *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return "0x64", nil
+	})
+	requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return "0x10", nil
+	})
+	return requester
+}
+
+func TestEnableCaching(t *testing.T) {
+
+	Convey("Subject: Cache gas price and epoch number", t, func() {
+		requester := newCachingRequester()
+		client, _ := NewClientWithRPCRequester(requester)
+		clock := newFakeClock()
+		client.SetClock(clock)
+
+		Convey("Given caching is not enabled", func() {
+
+			Convey("Every call requests node", func() {
+				client.GetGasPrice()
+				client.GetGasPrice()
+				So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("Given caching is enabled with ttl 5 seconds", func() {
+			client.EnableCaching(5 * time.Second)
+
+			Convey("When get gas price and latest state epoch repeatedly within ttl", func() {
+				for i := 0; i < 3; i++ {
+					gasPrice, err := client.GetGasPrice()
+					So(err, ShouldBeNil)
+					So(gasPrice.Int64(), ShouldEqual, 100)
+					// modify the returned value should not pollute the cache
+					gasPrice.SetInt64(0)
+
+					epoch, err := client.GetEpochNumber(types.EpochLatestState)
+					So(err, ShouldBeNil)
+					So(epoch.Int64(), ShouldEqual, 16)
+				}
+
+				Convey("Node is requested only once", func() {
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+					So(len(requester.CallsOf("cfx_epochNumber")), ShouldEqual, 1)
+				})
+			})
+
+			Convey("When get the epoch number of other epochs", func() {
+				client.GetEpochNumber(types.EpochLatestMined)
+				client.GetEpochNumber(types.EpochLatestMined)
+
+				Convey("They are not cached", func() {
+					So(len(requester.CallsOf("cfx_epochNumber")), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When get gas price after ttl", func() {
+				client.GetGasPrice()
+				clock.Sleep(5 * time.Second)
+				client.GetGasPrice()
+
+				Convey("Node is requested again", func() {
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When get gas price concurrently", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						client.GetGasPrice()
+					}()
+				}
+				wg.Wait()
+
+				Convey("Gas price is cached", func() {
+					gasPrice, err := client.GetGasPrice()
+					So(err, ShouldBeNil)
+					So(gasPrice.Int64(), ShouldEqual, 100)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,338 @@
+package sdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestApplyUnsignedTransactionDefaultValidation(t *testing.T) {
+
+	Convey("Subject: Validate transaction when applying default", t, func() {
+		client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+		from := types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		to := types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+		Convey("When From is not set and no account manager", func() {
+			err := client.ApplyUnsignedTransactionDefault(&types.UnsignedTransaction{To: to})
+
+			Convey("Return error instead of panic", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "from of transaction is not set")
+			})
+		})
+
+		Convey("When neither To nor Data is set", func() {
+			tx := &types.UnsignedTransaction{}
+			tx.From = from
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "data is necessary for creating contract")
+			})
+		})
+
+		Convey("When value is negative", func() {
+			tx := &types.UnsignedTransaction{To: to}
+			tx.From = from
+			tx.Value = (*hexutil.Big)(big.NewInt(-1))
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "value of transaction should not be negative")
+			})
+		})
+
+		Convey("When gas is negative", func() {
+			tx := &types.UnsignedTransaction{Data: []byte{0x60, 0x80}}
+			tx.From = from
+			tx.Gas = (*hexutil.Big)(big.NewInt(-21000))
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "gas of transaction should not be negative")
+			})
+		})
+	})
+}
+
+func TestGetChainID(t *testing.T) {
+
+	Convey("Subject: Get chain id", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"chainId": "0x405"}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When get chain id twice", func() {
+			first, err := client.GetChainID()
+			second, _ := client.GetChainID()
+
+			Convey("Return the cached chain id", func() {
+				So(err, ShouldBeNil)
+				So(first.Int64(), ShouldEqual, 1029)
+				So(second.Int64(), ShouldEqual, 1029)
+				So(len(requester.CallsOf("cfx_getStatus")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When apply default for a transaction without chain id", func() {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			tx.Nonce = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(1)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("The chain id of node is filled", func() {
+				So(err, ShouldBeNil)
+				So(tx.ChainID.ToInt().Int64(), ShouldEqual, 1029)
+			})
+		})
+	})
+}
+
+func TestGetNetworkID(t *testing.T) {
+
+	Convey("Subject: Get network id", t, func() {
+
+		Convey("Given a node responds network id", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return map[string]interface{}{"chainId": "0x1", "networkId": "0x2", "epochNumber": "0x10"}, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return the status and cached ids fetched in one request", func() {
+				status, err := client.GetStatus()
+				So(err, ShouldBeNil)
+				So(status.NetworkID.ToInt().Int64(), ShouldEqual, 2)
+				So(status.EpochNumber.ToInt().Int64(), ShouldEqual, 16)
+
+				networkID, err := client.GetNetworkID()
+				So(err, ShouldBeNil)
+				So(networkID.Int64(), ShouldEqual, 2)
+				chainID, _ := client.GetChainID()
+				So(chainID.Int64(), ShouldEqual, 1)
+				So(len(requester.CallsOf("cfx_getStatus")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("Given a node does not respond network id", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return map[string]interface{}{"chainId": "0x405"}, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return the chain id as network id", func() {
+				networkID, err := client.GetNetworkID()
+				So(err, ShouldBeNil)
+				So(networkID.Int64(), ShouldEqual, 1029)
+			})
+		})
+	})
+}
+
+func TestApplyEstimateMargin(t *testing.T) {
+
+	Convey("Subject: Add safety margin to estimate when applying default", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_estimateGasAndCollateral").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]string{"gasUsed": "0x5208", "storageCollateralized": "0x40"}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		newTx := func() *types.UnsignedTransaction {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			return tx
+		}
+
+		Convey("When apply default with default margins", func() {
+			tx := newTx()
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("Gas and storage limit are 20% and 10% more than estimate", func() {
+				So(err, ShouldBeNil)
+				So(tx.Gas.ToInt().Int64(), ShouldEqual, 25200)
+				// 64 * 1.1 = 70.4 is rounded up
+				So(tx.StorageLimit.ToInt().Int64(), ShouldEqual, 71)
+			})
+		})
+
+		Convey("When apply default with custom margins", func() {
+			client.SetEstimateMargin(0.5, -1)
+			tx := newTx()
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("The custom margins are used", func() {
+				So(err, ShouldBeNil)
+				So(tx.Gas.ToInt().Int64(), ShouldEqual, 31500)
+				So(tx.StorageLimit.ToInt().Int64(), ShouldEqual, 64)
+			})
+		})
+
+		Convey("When gas and storage limit are specified", func() {
+			tx := newTx()
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("They are not changed", func() {
+				So(err, ShouldBeNil)
+				So(tx.Gas.ToInt().Int64(), ShouldEqual, 21000)
+				So(tx.StorageLimit.ToInt().Int64(), ShouldEqual, 0)
+				So(len(requester.CallsOf("cfx_estimateGasAndCollateral")), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestNetworkCheck(t *testing.T) {
+
+	Convey("Subject: Check network of transaction before signing", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+		requester := sdktest.NewMockRequester()
+		nodeChainID := "0x1"
+		requester.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"chainId": nodeChainID}, nil
+		})
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetSigner(NewPrivateKeySigner(privateKey))
+
+		newTx := func(chainID int64) *types.UnsignedTransaction {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(chainID)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			return tx
+		}
+
+		Convey("When network check is disabled", func() {
+			_, err := client.SendTransaction(newTx(1029))
+
+			Convey("The transaction of another network is sent", func() {
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_getStatus")), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When network check is enabled", func() {
+			client.SetNetworkCheck(true)
+
+			Convey("The transaction of another network is rejected", func() {
+				_, err := client.SendTransaction(newTx(1029))
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "mismatches the chain id 1 of node")
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+			})
+
+			Convey("The transaction of same network is sent", func() {
+				_, err := client.SendTransaction(newTx(1))
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+			})
+
+			Convey("The call is sent without requesting node status every time", func() {
+				_, err := client.Call(types.CallRequest{To: newTx(1).To}, nil)
+				So(err, ShouldBeNil)
+				_, err = client.Call(types.CallRequest{To: newTx(1).To}, nil)
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_call")), ShouldEqual, 2)
+				So(len(requester.CallsOf("cfx_getStatus")), ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Subject: Check network of node after failover", t, func() {
+		request := types.CallRequest{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+
+		primaryDown := false
+		primary := sdktest.NewMockRequester()
+		primary.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if primaryDown {
+				return nil, errors.New("connection reset by peer")
+			}
+			return map[string]interface{}{"chainId": "0x1"}, nil
+		})
+		primary.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if primaryDown {
+				return nil, errors.New("connection reset by peer")
+			}
+			return "0x", nil
+		})
+
+		secondaryChainID := "0x405"
+		secondary := sdktest.NewMockRequester()
+		secondary.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"chainId": secondaryChainID}, nil
+		})
+		secondary.OnAny("cfx_call").Return("0x")
+
+		client, _ := NewClientWithRPCRequester(&failoverRequester{
+			endpoints: []*endpoint{
+				{url: "http://primary", requester: primary},
+				{url: "http://secondary", requester: secondary},
+			},
+			maxFailures:     1,
+			recoverInterval: time.Minute,
+		})
+		client.SetNetworkCheck(true)
+		_, err := client.Call(request, nil)
+		So(err, ShouldBeNil)
+		primaryDown = true
+
+		Convey("When failed over to the node on another network", func() {
+			_, err := client.Call(request, nil)
+			So(err, ShouldBeNil)
+
+			Convey("The following call is rejected without sending", func() {
+				_, err := client.Call(request, nil)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "the node is on another network")
+				So(len(secondary.CallsOf("cfx_call")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When failed over to the node on the same network", func() {
+			secondaryChainID = "0x1"
+			for i := 0; i < 3; i++ {
+				_, err := client.Call(request, nil)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("The node is checked only once after switched", func() {
+				So(len(secondary.CallsOf("cfx_call")), ShouldEqual, 3)
+				So(len(secondary.CallsOf("cfx_getStatus")), ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSendTransactionWithBalanceCheck(t *testing.T) {
+
+	Convey("Subject: Send transaction with balance check", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		newTx := func() *types.UnsignedTransaction {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = &from
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(10)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			tx.Value = types.NewBigInt(1000)
+			return tx
+		}
+
+		newRequester := func(balance string) *sdktest.MockRequester {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return balance, nil
+			})
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa1", nil
+			})
+			return requester
+		}
+
+		Convey("Given the balance is enough", func() {
+			// 21000 * 10 + 1000 = 211000
+			requester := newRequester("0x33838")
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("Send the transaction", func() {
+				hash, err := client.SendTransactionWithBalanceCheck(newTx())
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, types.Hash("0xa1"))
+			})
+		})
+
+		Convey("Given the balance is not enough", func() {
+			requester := newRequester("0x33837")
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("Return InsufficientBalanceError without sending", func() {
+				_, err := client.SendTransactionWithBalanceCheck(newTx())
+
+				var balanceErr *types.InsufficientBalanceError
+				So(errors.As(err, &balanceErr), ShouldBeTrue)
+				So(balanceErr.Shortfall().Int64(), ShouldEqual, 1)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+			})
+		})
+	})
+}
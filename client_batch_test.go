@@ -0,0 +1,247 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/constants"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBatchGetCode(t *testing.T) {
+
+	Convey("Subject: Batch get code of contracts", t, func() {
+
+		Convey("Given a node knows the code of some addresses", func() {
+			codes := map[types.Address]string{
+				types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd"): "0x6080",
+				types.Address("0x8b8689c7f3014a4d86e4d1d0daaf74a47f5e0f27"): "0x",
+			}
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getCode").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				code, ok := codes[args[0].(types.Address)]
+				if !ok {
+					return nil, errors.New("invalid address")
+				}
+				return code, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("When batch get code of known addresses", func() {
+				addresses := []types.Address{
+					"0x8b8689c7f3014a4d86e4d1d0daaf74a47f5e0f27",
+					"0x8d5adbcaf5714924830591586f05302bf87f74bd",
+				}
+				result, err := client.BatchGetCode(addresses, types.EpochLatestState)
+
+				Convey("Return codes aligned with addresses", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldResemble, []string{"0x", "0x6080"})
+					So(len(requester.CallsOf("cfx_getCode")[0].Args), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When batch get code with an unknown address", func() {
+				addresses := []types.Address{
+					"0x8d5adbcaf5714924830591586f05302bf87f74bd",
+					"0x1000000000000000000000000000000000000000",
+				}
+				result, err := client.BatchGetCode(addresses, nil)
+
+				Convey("Return codes along with errors of failed elements", func() {
+					So(result, ShouldResemble, []string{"0x6080", ""})
+					elemErrs, ok := err.(types.BatchElemErrors)
+					So(ok, ShouldBeTrue)
+					So(len(elemErrs), ShouldEqual, 1)
+					So(elemErrs[1], ShouldNotBeNil)
+				})
+			})
+
+			Convey("When batch get code of no address", func() {
+				result, err := client.BatchGetCode(nil, nil)
+
+				Convey("Return empty codes", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldBeEmpty)
+				})
+			})
+		})
+	})
+}
+
+func TestBatchGetRawBlockConfirmationRisk(t *testing.T) {
+
+	Convey("Subject: Batch get raw confirmation risk of blocks", t, func() {
+		risky := types.Hash("0xa000000000000000000000000000000000000000000000000000000000000001")
+		executed := types.Hash("0xa000000000000000000000000000000000000000000000000000000000000002")
+		unknown := types.Hash("0xa000000000000000000000000000000000000000000000000000000000000003")
+		malformed := types.Hash("0xa0")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getConfirmationRiskByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			switch args[0].(types.Hash) {
+			case risky:
+				return "0x10", nil
+			case malformed:
+				return nil, errors.New("invalid length 1, expected a 0x-prefixed hex string with length of 64")
+			}
+			return nil, nil
+		})
+		requester.OnAny("cfx_getBlockByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if args[0].(types.Hash) == executed {
+				return map[string]interface{}{"hash": executed, "epochNumber": "0x10"}, nil
+			}
+			return nil, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When get risks of valid, unknown and duplicate block hashes", func() {
+			risks, err := client.BatchGetRawBlockConfirmationRisk([]types.Hash{risky, executed, unknown, risky, executed})
+
+			Convey("Return risk of each distinct block hash", func() {
+				So(err, ShouldBeNil)
+				So(len(risks), ShouldEqual, 3)
+				So(risks[risky].Int64(), ShouldEqual, 16)
+				So(risks[executed].Int64(), ShouldEqual, 0)
+				So(risks[unknown], ShouldEqual, constants.MaxUint256)
+			})
+
+			Convey("Request each distinct block hash once", func() {
+				So(len(requester.CallsOf("cfx_getConfirmationRiskByHash")), ShouldEqual, 3)
+				So(len(requester.CallsOf("cfx_getBlockByHash")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When get risks with a malformed block hash", func() {
+			_, err := client.BatchGetRawBlockConfirmationRisk([]types.Hash{risky, malformed})
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When get block summaries of existing and unknown block hashes", func() {
+			summaries, err := client.BatchGetBlockSummarys([]types.Hash{executed, unknown, executed})
+
+			Convey("Return nil summary for the unknown block", func() {
+				So(err, ShouldBeNil)
+				So(summaries[executed].EpochNumber.ToInt().Int64(), ShouldEqual, 16)
+				So(summaries[unknown], ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestBatchRequest(t *testing.T) {
+
+	Convey("Subject: Batch heterogeneous rpc requests", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x64", nil
+		})
+		requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x2", nil
+		})
+		requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nil, errors.New("invalid transaction hash")
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		address := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		Convey("When execute a balance, a nonce and a receipt request", func() {
+			var balance, nonce string
+			var receipt *types.TransactionReceipt
+
+			batch := client.NewBatchRequest()
+			batch.Add("cfx_getBalance", &balance, address)
+			batch.Add("cfx_getNextNonce", &nonce, address)
+			receiptIndex := batch.Add("cfx_getTransactionReceipt", &receipt, types.Hash("0xa0"))
+			err := batch.Execute()
+
+			Convey("Return results and per-element errors in one round trip", func() {
+				So(batch.Len(), ShouldEqual, 3)
+				So(balance, ShouldEqual, "0x64")
+				So(nonce, ShouldEqual, "0x2")
+
+				elemErrs, ok := err.(types.BatchElemErrors)
+				So(ok, ShouldBeTrue)
+				So(len(elemErrs), ShouldEqual, 1)
+				So(elemErrs[receiptIndex], ShouldNotBeNil)
+				So(batch.Error(receiptIndex), ShouldNotBeNil)
+				So(batch.Error(0), ShouldBeNil)
+			})
+
+			Convey("Return ErrBatchIndexOutOfRange for the index not added", func() {
+				So(errors.Is(batch.Error(3), ErrBatchIndexOutOfRange), ShouldBeTrue)
+				So(errors.Is(batch.Error(-1), ErrBatchIndexOutOfRange), ShouldBeTrue)
+				So(errors.Is(batch.Error(receiptIndex), ErrBatchIndexOutOfRange), ShouldBeFalse)
+			})
+		})
+
+		Convey("When execute an empty batch", func() {
+			err := client.NewBatchRequest().Execute()
+
+			Convey("Return nil", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestBatchGetTransactionReceipts(t *testing.T) {
+
+	Convey("Subject: Batch get transaction receipts", t, func() {
+		executed := types.Hash("0xb000000000000000000000000000000000000000000000000000000000000001")
+		pending := types.Hash("0xb000000000000000000000000000000000000000000000000000000000000002")
+		malformed := types.Hash("0xb0")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			switch args[0].(types.Hash) {
+			case executed:
+				return map[string]interface{}{"transactionHash": executed, "outcomeStatus": 1}, nil
+			case malformed:
+				return nil, errors.New("invalid transaction hash")
+			}
+			return nil, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When get receipts of executed and pending transactions", func() {
+			receipts, err := client.BatchGetTransactionReceipts([]types.Hash{pending, executed, pending})
+
+			Convey("Return receipts aligned with hashes and nil for not found", func() {
+				So(err, ShouldBeNil)
+				So(len(receipts), ShouldEqual, 3)
+				So(receipts[0], ShouldBeNil)
+				So(receipts[1].TransactionHash, ShouldEqual, executed)
+				So(receipts[1].OutcomeStatus, ShouldEqual, types.OutcomeFailed)
+				So(receipts[2], ShouldBeNil)
+			})
+		})
+
+		Convey("When get receipts with a malformed hash", func() {
+			receipts, err := client.BatchGetTransactionReceipts([]types.Hash{executed, malformed})
+
+			Convey("Return receipts along with errors of failed elements", func() {
+				So(receipts[0], ShouldNotBeNil)
+				So(receipts[1], ShouldBeNil)
+				elemErrs, ok := err.(types.BatchElemErrors)
+				So(ok, ShouldBeTrue)
+				So(len(elemErrs), ShouldEqual, 1)
+				So(elemErrs[1], ShouldNotBeNil)
+			})
+		})
+
+		Convey("When get receipts of no hash", func() {
+			receipts, err := client.BatchGetTransactionReceipts(nil)
+
+			Convey("Return empty receipts", func() {
+				So(err, ShouldBeNil)
+				So(receipts, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetBlockHeader(t *testing.T) {
+
+	Convey("Subject: Get block header", t, func() {
+		block := json.RawMessage(`{"hash":"0xb2","parentHash":"0xb1","height":"0x64","epochNumber":"0x65",` +
+			`"timestamp":"0x5f5e1000","difficulty":"0x3e8","miner":"0x1cad0b19bb29d4674531d6f115237e16afce377c",` +
+			`"transactions":["0xa1","0xa2"]}`)
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getBlockByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if args[0] == types.Hash("0xb2") {
+				return block, nil
+			}
+			return nil, nil
+		})
+		requester.OnAny("cfx_getBlockByEpochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return block, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When get header by hash", func() {
+			header, err := client.GetBlockHeaderByHash("0xb2")
+
+			Convey("Return the header without transactions", func() {
+				So(err, ShouldBeNil)
+				So(header.ParentHash, ShouldEqual, types.Hash("0xb1"))
+				So(header.Height.ToInt().Int64(), ShouldEqual, 100)
+				So(header.EpochNumber.ToInt().Int64(), ShouldEqual, 101)
+				So(uint64(*header.Timestamp), ShouldEqual, 0x5f5e1000)
+				So(header.Difficulty.ToInt().Int64(), ShouldEqual, 1000)
+				So(header.Extra, ShouldBeNil)
+				So(requester.CallsOf("cfx_getBlockByHash")[0].Args[1], ShouldEqual, false)
+			})
+		})
+
+		Convey("When get header of not existed block", func() {
+			header, err := client.GetBlockHeaderByHash("0xb3")
+			So(err, ShouldBeNil)
+			So(header, ShouldBeNil)
+
+			client.SetNotFoundAsError(true)
+			_, err = client.GetBlockHeaderByHash("0xb3")
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+		})
+
+		Convey("When get header by epoch", func() {
+			header, err := client.GetBlockHeaderByEpoch(types.NewEpochNumber(big.NewInt(101)))
+
+			Convey("Return the header of pivot block", func() {
+				So(err, ShouldBeNil)
+				So(header.Hash, ShouldEqual, types.Hash("0xb2"))
+				So(requester.CallsOf("cfx_getBlockByEpochNumber")[0].Args[1], ShouldEqual, false)
+			})
+		})
+	})
+}
+
+func TestSubscribeNewHeads(t *testing.T) {
+
+	Convey("Subject: Subscribe new block headers", t, func() {
+
+		Convey("Given the node publishes two new headers", func() {
+			client, cleanup := newPubSubClient(&pubSubService{newHeads: []interface{}{
+				json.RawMessage(`{"hash":"0xb1","parentHash":"0xb0","height":"0x64","epochNumber":"0x64","timestamp":"0x5f5e1000"}`),
+				json.RawMessage(`{"hash":"0xb2","parentHash":"0xb1","height":"0x65","epochNumber":"0x65","timestamp":"0x5f5e1001"}`),
+			}})
+			defer cleanup()
+
+			headers := make(chan types.BlockHeader)
+			sub, err := client.SubscribeNewHeads(context.Background(), headers)
+			So(err, ShouldBeNil)
+			defer sub.Unsubscribe()
+
+			Convey("The decoded headers are delivered in order", func() {
+				for i, hash := range []types.Hash{"0xb1", "0xb2"} {
+					select {
+					case header := <-headers:
+						So(header.Hash, ShouldEqual, hash)
+						So(header.Height.ToInt().Int64(), ShouldEqual, int64(100+i))
+						So(header.EpochNumber.ToInt().Int64(), ShouldEqual, int64(100+i))
+						So(uint64(*header.Timestamp), ShouldEqual, uint64(0x5f5e1000+i))
+					case err := <-sub.Err():
+						t.Fatalf("subscription failed: %v", err)
+					case <-time.After(time.Second):
+						t.Fatal("header is not delivered")
+					}
+				}
+			})
+		})
+
+		Convey("Given the rpc requester does not support subscription", func() {
+			client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+
+			Convey("Return error", func() {
+				_, err := client.SubscribeNewHeads(context.Background(), make(chan types.BlockHeader))
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
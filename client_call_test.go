@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCallAndUnmarshal(t *testing.T) {
+
+	Convey("Subject: Call rpc and unmarshal typed result", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getSupplyInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"totalIssued": "0x64", "totalStaking": "0x10", "totalCollateral": "0x1"}, nil
+		})
+		requester.OnAny("cfx_getAccountPendingInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		requester.OnAny("cfx_unknown").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nil, errors.New("the method cfx_unknown does not exist/is not available")
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When call and unmarshal into a struct", func() {
+			var info types.SupplyInfo
+			err := client.CallAndUnmarshal(&info, "cfx_getSupplyInfo")
+
+			Convey("Return the typed result", func() {
+				So(err, ShouldBeNil)
+				So(info.TotalIssued.ToInt().Int64(), ShouldEqual, 100)
+				So(info.TotalStaking.ToInt().Int64(), ShouldEqual, 16)
+			})
+		})
+
+		Convey("When the rpc responds null", func() {
+			info := &types.AccountPendingInfo{}
+			err := client.CallAndUnmarshal(&info, "cfx_getAccountPendingInfo", types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+
+			Convey("The pointer is set to nil", func() {
+				So(err, ShouldBeNil)
+				So(info, ShouldBeNil)
+			})
+		})
+
+		Convey("When the rpc failed", func() {
+			var result string
+			err := client.CallAndUnmarshal(&result, "cfx_unknown")
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCallRequestValidation(t *testing.T) {
+
+	Convey("Subject: Validate call request before sending", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x", nil
+		})
+		requester.OnAny("cfx_estimateGasAndCollateral").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"gasUsed": "0x5208", "storageCollateralized": "0x0"}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		to := types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+		Convey("When the address of request is malformed", func() {
+			request := types.CallRequest{To: types.NewAddress("0x1cad")}
+			_, callErr := client.Call(request, nil)
+			_, estimateErr := client.EstimateGasAndCollateral(request)
+
+			Convey("Return error without sending", func() {
+				So(callErr, ShouldNotBeNil)
+				So(estimateErr, ShouldNotBeNil)
+				So(len(requester.Calls()), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the data exceeds the max size", func() {
+			client.SetMaxCallDataSize(4)
+			request := types.CallRequest{To: to, Data: make([]byte, 5)}
+			_, callErr := client.Call(request, nil)
+			_, estimateErr := client.EstimateGasAndCollateral(request)
+
+			Convey("Return ErrCallDataTooLarge without sending", func() {
+				So(errors.Is(callErr, ErrCallDataTooLarge), ShouldBeTrue)
+				So(errors.Is(estimateErr, ErrCallDataTooLarge), ShouldBeTrue)
+				So(len(requester.Calls()), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the data is within the max size", func() {
+			client.SetMaxCallDataSize(4)
+			_, err := client.Call(types.CallRequest{To: to, Data: make([]byte, 4)}, nil)
+
+			Convey("The request is sent", func() {
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_call")), ShouldEqual, 1)
+			})
+		})
+	})
+}
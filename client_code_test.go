@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerifyDeployedCode(t *testing.T) {
+
+	Convey("Subject: Verify deployed code", t, func() {
+		address := types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getCode").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			// runtime code 0x6080604052 followed by 3 bytes metadata and its length
+			return "0x6080604052a101020003", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When the expected code is same as deployed", func() {
+			ok, err := client.VerifyDeployedCode(address, hexutil.MustDecode("0x6080604052a101020003"))
+
+			Convey("Return matched", func() {
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+			})
+		})
+
+		Convey("When the expected code is different from deployed", func() {
+			ok, err := client.VerifyDeployedCode(address, hexutil.MustDecode("0x6080604053a101020003"))
+
+			Convey("Return mismatched", func() {
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the expected code only differs in metadata", func() {
+			expected := hexutil.MustDecode("0x6080604052a109090003")
+
+			Convey("Return mismatched without tolerance", func() {
+				ok, err := client.VerifyDeployedCode(address, expected)
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("Return matched if ignore metadata", func() {
+				ok, err := client.VerifyDeployedCode(address, expected, &types.CodeVerifyOption{IgnoreMetadata: true})
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Return matched if ignore trailing bytes", func() {
+				ok, err := client.VerifyDeployedCode(address, expected, &types.CodeVerifyOption{IgnoreTrailingBytes: 4})
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+}
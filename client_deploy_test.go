@@ -0,0 +1,230 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeployContractSync(t *testing.T) {
+
+	Convey("Subject: Deploy contract synchronously", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		option := &types.ContractDeployOption{}
+		option.From = &from
+		option.Nonce = types.NewBigInt(1)
+		option.ChainID = types.NewBigInt(1)
+		option.GasPrice = types.NewBigInt(1)
+		option.EpochHeight = types.NewBigInt(100)
+		option.Gas = types.NewBigInt(1000000)
+		option.StorageLimit = types.NewBigInt(1024)
+
+		Convey("Given the deploying transaction is executed successfully", func() {
+			requester := newMinedRequester("0x0")
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa1", nil
+			})
+			requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return map[string]interface{}{"hash": args[0], "blockHash": "0xb1", "status": "0x0",
+					"contractCreated": "0x8d1089f00c40dcc290968b366889e85e67024662"}, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("When deploy contract", func() {
+				contract, txHash, err := client.DeployContractSync(option, []byte(erc20ABI), []byte{0x60, 0x80},
+					big.NewInt(100000), "biu", uint8(10), "BIU")
+
+				Convey("Return the deployed contract and transaction hash", func() {
+					So(err, ShouldBeNil)
+					So(txHash, ShouldEqual, types.Hash("0xa1"))
+					So(*contract.Address, ShouldEqual, types.Address("0x8d1089f00c40dcc290968b366889e85e67024662"))
+				})
+			})
+		})
+
+		Convey("Given the constructor args are invalid", func() {
+			client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+			client.SetAccountManager(am)
+
+			Convey("When deploy contract", func() {
+				contract, txHash, err := client.DeployContractSync(option, []byte(erc20ABI), []byte{0x60, 0x80}, "biu")
+
+				Convey("Return error without transaction hash", func() {
+					So(err, ShouldNotBeNil)
+					So(contract, ShouldBeNil)
+					So(txHash, ShouldEqual, types.Hash(""))
+				})
+			})
+		})
+	})
+}
+
+func TestDeployContractWithContext(t *testing.T) {
+
+	Convey("Subject: Cancel deploying contract", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		option := &types.ContractDeployOption{}
+		option.From = &from
+		option.Nonce = types.NewBigInt(1)
+		option.ChainID = types.NewBigInt(1)
+		option.GasPrice = types.NewBigInt(1)
+		option.EpochHeight = types.NewBigInt(100)
+		option.Gas = types.NewBigInt(1000000)
+		option.StorageLimit = types.NewBigInt(1024)
+
+		requester := newMinedRequester("")
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetAccountManager(am)
+
+		Convey("When the context is cancelled before sending", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			result := client.DeployContractWithContext(ctx, option, []byte(erc20ABI), []byte{0x60, 0x80},
+				big.NewInt(100000), "biu", uint8(10), "BIU")
+			<-result.DoneChannel
+
+			Convey("Return context error without sending transaction", func() {
+				So(errors.Is(result.Error, context.Canceled), ShouldBeTrue)
+				So(result.TransactionHash, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the context is done while waiting for the transaction mined", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			result := client.DeployContractWithContext(ctx, option, []byte(erc20ABI), []byte{0x60, 0x80},
+				big.NewInt(100000), "biu", uint8(10), "BIU")
+			<-result.DoneChannel
+
+			Convey("Return context error along with the transaction hash", func() {
+				So(errors.Is(result.Error, context.DeadlineExceeded), ShouldBeTrue)
+				So(*result.TransactionHash, ShouldEqual, types.Hash("0xa1"))
+			})
+		})
+	})
+}
+
+func TestDeployTimeout(t *testing.T) {
+	Convey("Given a deploy option without timeout", t, func() {
+		option := &types.ContractDeployOption{}
+
+		Convey("Wait at most 1 hour if the context has no deadline", func() {
+			timeout, ok := deployTimeout(context.Background(), option)
+			So(ok, ShouldBeTrue)
+			So(timeout, ShouldEqual, time.Hour)
+
+			timeout, ok = deployTimeout(context.Background(), nil)
+			So(ok, ShouldBeTrue)
+			So(timeout, ShouldEqual, time.Hour)
+		})
+
+		Convey("Wait until the deadline of the context", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Hour)
+			defer cancel()
+			_, ok := deployTimeout(ctx, option)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Wait for the specified timeout", func() {
+			option.Timeout = time.Minute
+			timeout, ok := deployTimeout(context.Background(), option)
+			So(ok, ShouldBeTrue)
+			So(timeout, ShouldEqual, time.Minute)
+		})
+	})
+}
+
+func TestDeployContractStatus(t *testing.T) {
+
+	Convey("Subject: Notify intermediate status of deploying contract", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		option := &types.ContractDeployOption{PollInterval: time.Millisecond}
+		option.From = &from
+		option.Nonce = types.NewBigInt(1)
+		option.ChainID = types.NewBigInt(1)
+		option.GasPrice = types.NewBigInt(1)
+		option.EpochHeight = types.NewBigInt(100)
+		option.Gas = types.NewBigInt(1000000)
+		option.StorageLimit = types.NewBigInt(1024)
+
+		Convey("Given the deploying transaction is packed before executed", func() {
+			polls := 0
+			requester := newMinedRequester("0x0")
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa1", nil
+			})
+			requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				switch {
+				case polls == 1:
+					return nil, nil
+				case polls < 4:
+					return map[string]interface{}{"hash": args[0], "blockHash": "0xb1"}, nil
+				}
+				return map[string]interface{}{"hash": args[0], "blockHash": "0xb1", "status": "0x0",
+					"contractCreated": "0x8d1089f00c40dcc290968b366889e85e67024662"}, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("When deploy contract with a short poll interval", func() {
+				result := client.DeployContract(option, []byte(erc20ABI), []byte{0x60, 0x80},
+					big.NewInt(100000), "biu", uint8(10), "BIU")
+				<-result.DoneChannel
+
+				var statuses []ContractDeployStatus
+				for status := range result.StatusChannel {
+					statuses = append(statuses, status)
+				}
+
+				Convey("Receive each status once in order", func() {
+					So(result.Error, ShouldBeNil)
+					So(statuses, ShouldResemble, []ContractDeployStatus{
+						DeployStatusSubmitted, DeployStatusPacked, DeployStatusExecuted,
+					})
+					So(polls, ShouldEqual, 4)
+				})
+			})
+		})
+
+		Convey("Given the deploying transaction is never packed", func() {
+			requester := newMinedRequester("")
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa1", nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("When deploy contract with timeout", func() {
+				option.Timeout = 20 * time.Millisecond
+				result := client.DeployContract(option, []byte(erc20ABI), []byte{0x60, 0x80},
+					big.NewInt(100000), "biu", uint8(10), "BIU")
+				<-result.DoneChannel
+
+				Convey("Return timeout error after submitted", func() {
+					So(errors.Is(result.Error, context.DeadlineExceeded), ShouldBeTrue)
+					So(<-result.StatusChannel, ShouldEqual, DeployStatusSubmitted)
+					_, ok := <-result.StatusChannel
+					So(ok, ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
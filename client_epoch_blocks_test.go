@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetBlockSummariesByEpoch(t *testing.T) {
+
+	Convey("Subject: Get block summaries of epoch", t, func() {
+		pivot := types.Hash("0xb000000000000000000000000000000000000000000000000000000000000001")
+		referee := types.Hash("0xb000000000000000000000000000000000000000000000000000000000000002")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if args[0].(*types.Epoch).Equals(types.NewEpochNumberUint64(16)) {
+				return []types.Hash{referee, pivot}, nil
+			}
+			return []types.Hash{}, nil
+		})
+		requester.OnAny("cfx_getBlockByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"hash": args[0], "epochNumber": "0x10"}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When get summaries of epoch with 2 blocks", func() {
+			summaries, err := client.GetBlockSummariesByEpoch(types.NewEpochNumberUint64(16))
+
+			Convey("Return summaries in the order of block hashes by a single batch", func() {
+				So(err, ShouldBeNil)
+				So(len(summaries), ShouldEqual, 2)
+				So(summaries[0].Hash, ShouldEqual, referee)
+				So(summaries[1].Hash, ShouldEqual, pivot)
+				So(len(requester.CallsOf("cfx_getBlockByHash")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When get summaries of epoch without block", func() {
+			summaries, err := client.GetBlockSummariesByEpoch(types.NewEpochNumberUint64(17))
+
+			Convey("Return empty summaries", func() {
+				So(err, ShouldBeNil)
+				So(summaries, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestGetBlockRewardInfo(t *testing.T) {
+
+	Convey("Subject: Get block reward info of epoch", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getBlockRewardInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return []map[string]interface{}{{
+				"blockHash":   "0xb1",
+				"author":      "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+				"totalReward": "0x6c6b935b8bbd400000",
+				"baseReward":  "0x6c6b935b8bbd400000",
+				"txFee":       "0x0",
+			}}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		rewards, err := client.GetBlockRewardInfo(types.NewEpochNumberUint64(16))
+		So(err, ShouldBeNil)
+		So(len(rewards), ShouldEqual, 1)
+		So(rewards[0].Author, ShouldEqual, types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+		expect, _ := new(big.Int).SetString("2000000000000000000000", 10)
+		So(rewards[0].TotalReward.ToInt(), ShouldResemble, expect)
+		So(requester.CallsOf("cfx_getBlockRewardInfo")[0].Args[0], ShouldResemble, types.NewEpochNumberUint64(16))
+	})
+}
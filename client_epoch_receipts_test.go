@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetEpochReceipts(t *testing.T) {
+
+	Convey("Subject: Get receipts of epoch", t, func() {
+		receipt := func(hash string, index int) string {
+			return fmt.Sprintf(`{"transactionHash":"%v","index":%v,"blockHash":"0xb1","epochNumber":10,`+
+				`"from":"0x1cad0b19bb29d4674531d6f115237e16afce377c","gasUsed":"0x5208","logs":[],"logsBloom":"0x0",`+
+				`"stateRoot":"0x0","outcomeStatus":0,"storageCoveredBySponsor":false}`, hash, index)
+		}
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getEpochReceipts").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return json.RawMessage(`[[` + receipt("0xa1", 0) + `,` + receipt("0xa2", 1) + `],[],[` + receipt("0xa3", 0) + `]]`), nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		receipts, err := client.GetEpochReceipts(types.NewEpochNumber(big.NewInt(10)))
+
+		Convey("Return receipts grouped by blocks", func() {
+			So(err, ShouldBeNil)
+			So(len(receipts), ShouldEqual, 3)
+			So(len(receipts[0]), ShouldEqual, 2)
+			So(len(receipts[1]), ShouldEqual, 0)
+			So(receipts[0][1].TransactionHash, ShouldEqual, types.Hash("0xa2"))
+			So(receipts[0][1].Index, ShouldEqual, 1)
+			So(*receipts[2][0].EpochNumber, ShouldEqual, 10)
+			So(receipts[2][0].GasUsed.ToInt().Int64(), ShouldEqual, 21000)
+		})
+	})
+}
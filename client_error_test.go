@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeJSONError behaves like the JSON-RPC error responded by conflux node.
+type fakeJSONError struct {
+	code    int
+	message string
+	data    interface{}
+}
+
+func (e *fakeJSONError) Error() string          { return e.message }
+func (e *fakeJSONError) ErrorCode() int         { return e.code }
+func (e *fakeJSONError) ErrorData() interface{} { return e.data }
+
+func TestRPCError(t *testing.T) {
+
+	Convey("Subject: Typed JSON-RPC errors", t, func() {
+
+		Convey("Given a node rejects cfx_call with revert reason", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32015, "Transaction reverted", "Reverted 0x08c379a0" +
+					"0000000000000000000000000000000000000000000000000000000000000020" +
+					"0000000000000000000000000000000000000000000000000000000000000014" +
+					"696e73756666696369656e742062616c616e6365000000000000000000000000"}
+			})
+			requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, errors.New("connection refused")
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("When call contract", func() {
+				_, err := client.Call(types.CallRequest{}, nil)
+
+				Convey("Return RPCError with revert reason", func() {
+					var rpcErr *types.RPCError
+					So(errors.As(err, &rpcErr), ShouldBeTrue)
+					So(rpcErr.Code, ShouldEqual, -32015)
+					So(rpcErr.Message, ShouldEqual, "Transaction reverted")
+
+					reason, ok := rpcErr.RevertReason()
+					So(ok, ShouldBeTrue)
+					So(reason, ShouldEqual, "insufficient balance")
+					So(err.Error(), ShouldContainSubstring, "revert reason: insufficient balance")
+				})
+			})
+
+			Convey("When the request failed by transport error", func() {
+				_, err := client.GetGasPrice()
+
+				Convey("Return error which is not RPCError", func() {
+					var rpcErr *types.RPCError
+					So(err, ShouldNotBeNil)
+					So(errors.As(err, &rpcErr), ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
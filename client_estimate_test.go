@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newEstimateRequester returns a MockRequester which estimates 21000 gas and 64 storage for any request.
+func newEstimateRequester() *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_estimateGasAndCollateral").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return map[string]interface{}{"gasUsed": "0x5208", "storageCollateralized": "0x40"}, nil
+	})
+	return requester
+}
+
+func TestEstimateGasAndCollateralEpoch(t *testing.T) {
+
+	Convey("Subject: Estimate gas and collateral at epoch", t, func() {
+		requester := newEstimateRequester()
+		client, _ := NewClientWithRPCRequester(requester)
+		to := types.NewAddress("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+
+		Convey("When estimate without epoch", func() {
+			_, err := client.EstimateGasAndCollateral(types.CallRequest{To: to})
+
+			Convey("Only the request is sent", func() {
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_estimateGasAndCollateral")[0].Args), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When estimate at latest mined epoch", func() {
+			estimate, err := client.EstimateGasAndCollateral(types.CallRequest{To: to}, types.EpochLatestMined)
+
+			Convey("The epoch is forwarded in rpc args", func() {
+				So(err, ShouldBeNil)
+				So(estimate.GasUsed.ToInt().Int64(), ShouldEqual, 21000)
+				args := requester.CallsOf("cfx_estimateGasAndCollateral")[0].Args
+				So(len(args), ShouldEqual, 2)
+				So(args[1], ShouldEqual, types.EpochLatestMined)
+			})
+		})
+
+		Convey("When apply default for a transaction at epoch height 100", func() {
+			tx := &types.UnsignedTransaction{To: to}
+			tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			err := client.ApplyUnsignedTransactionDefault(tx)
+
+			Convey("The estimation is requested at epoch 100", func() {
+				So(err, ShouldBeNil)
+				So(tx.Gas.ToInt().Int64(), ShouldEqual, 21000)
+				args := requester.CallsOf("cfx_estimateGasAndCollateral")[0].Args
+				So(len(args), ShouldEqual, 2)
+				epoch, ok := args[1].(*types.Epoch).ToInt()
+				So(ok, ShouldBeTrue)
+				So(epoch, ShouldResemble, big.NewInt(100))
+			})
+		})
+	})
+}
+
+func TestBatchEstimateGasAndCollateral(t *testing.T) {
+
+	Convey("Subject: Batch estimate gas and collateral", t, func() {
+		reverting := types.NewAddress("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_estimateGasAndCollateral").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if request := args[0].(types.CallRequest); request.To != nil && *request.To == *reverting {
+				return nil, &fakeJSONError{-32015, "Transaction reverted", nil}
+			}
+			return map[string]interface{}{"gasUsed": "0x5208", "storageCollateralized": "0x40"}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		to := types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+		Convey("When estimate requests with a reverting call", func() {
+			requests := []types.CallRequest{{To: to}, {To: reverting}, {To: to}}
+			estimates, err := client.BatchEstimateGasAndCollateral(requests, types.EpochLatestState)
+
+			Convey("Return estimates of others along with the error of reverting call", func() {
+				So(len(estimates), ShouldEqual, 3)
+				So(estimates[0].GasUsed.ToInt().Int64(), ShouldEqual, 21000)
+				So(estimates[1], ShouldBeNil)
+				So(estimates[2].StorageCollateralized.ToInt().Int64(), ShouldEqual, 64)
+
+				elemErrs, ok := err.(types.BatchElemErrors)
+				So(ok, ShouldBeTrue)
+				So(len(elemErrs), ShouldEqual, 1)
+				So(elemErrs[1], ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_estimateGasAndCollateral")[0].Args), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When estimate no request", func() {
+			estimates, err := client.BatchEstimateGasAndCollateral(nil)
+
+			Convey("Return empty estimates", func() {
+				So(err, ShouldBeNil)
+				So(estimates, ShouldBeEmpty)
+			})
+		})
+	})
+}
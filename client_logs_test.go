@@ -0,0 +1,199 @@
+package sdk
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newLogsRequester returns a MockRequester which answers one log per epoch, and rejects the
+// cfx_getLogs queries spanning more than maxRange epochs.
+func newLogsRequester(maxRange uint64) *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_getLogs").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		filter := args[0].(types.LogFilter)
+		from, _ := filter.FromEpoch.ToInt()
+		to, _ := filter.ToEpoch.ToInt()
+		if to.Uint64()-from.Uint64()+1 > maxRange {
+			return nil, &fakeJSONError{-32602, "Filter error: This query results in too many logs, max limitation is 4, " +
+				"please filter results by a smaller epoch/block range", nil}
+		}
+
+		var logs []types.Log
+		for epoch := from.Uint64(); epoch <= to.Uint64(); epoch++ {
+			blockHash := types.Hash(hexutil.EncodeBig(new(big.Int).SetUint64(epoch)))
+			logs = append(logs, types.Log{
+				BlockHash:           &blockHash,
+				EpochNumber:         (*hexutil.Big)(new(big.Int).SetUint64(epoch)),
+				TransactionHash:     &blockHash,
+				TransactionLogIndex: (*hexutil.Big)(big.NewInt(0)),
+			})
+		}
+		return logs, nil
+	})
+	return requester
+}
+
+func TestGetLogsChunked(t *testing.T) {
+
+	Convey("Subject: Get logs chunked by epoch range", t, func() {
+
+		Convey("Given a node rejects queries spanning more than 4 epochs", func() {
+			requester := newLogsRequester(4)
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("When get logs of epoch [0, 24] with chunk size 10", func() {
+				filter := types.LogFilter{
+					FromEpoch: types.NewEpochNumber(big.NewInt(0)),
+					ToEpoch:   types.NewEpochNumber(big.NewInt(24)),
+				}
+				logs, err := client.GetLogsChunked(filter, 10)
+
+				Convey("Return all logs in order without duplication", func() {
+					So(err, ShouldBeNil)
+					So(len(logs), ShouldEqual, 25)
+					for i, log := range logs {
+						So(log.EpochNumber.ToInt().Uint64(), ShouldEqual, uint64(i))
+					}
+				})
+			})
+
+			Convey("When get logs with chunk size 0", func() {
+				filter := types.LogFilter{FromEpoch: types.NewEpochNumber(big.NewInt(0))}
+				_, err := client.GetLogsChunked(filter, 0)
+
+				Convey("Return error", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("Given a node rejects every query", func() {
+			requester := newLogsRequester(0)
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("When the window can not be halved anymore", func() {
+				filter := types.LogFilter{
+					FromEpoch: types.NewEpochNumber(big.NewInt(0)),
+					ToEpoch:   types.NewEpochNumber(big.NewInt(3)),
+				}
+				_, err := client.GetLogsChunked(filter, 4)
+
+				Convey("Return error", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("Given a node times out", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getLogs").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, errors.New("context deadline exceeded")
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("When get logs chunked", func() {
+				filter := types.LogFilter{
+					FromEpoch: types.NewEpochNumber(big.NewInt(0)),
+					ToEpoch:   types.NewEpochNumber(big.NewInt(24)),
+				}
+				_, err := client.GetLogsChunked(filter, 10)
+
+				Convey("Return the error without halving the window", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_getLogs")), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("Given a filter with limit", func() {
+			requester := newLogsRequester(4)
+			client, _ := NewClientWithRPCRequester(requester)
+			limit := uint8(5)
+			filter := types.LogFilter{
+				FromEpoch: types.NewEpochNumber(big.NewInt(0)),
+				ToEpoch:   types.NewEpochNumber(big.NewInt(24)),
+				Limit:     &limit,
+			}
+
+			Convey("Return error without requesting node", func() {
+				_, err := client.GetLogsChunked(filter, 4)
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_getLogs")), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestGetLogsInvalidFilter(t *testing.T) {
+
+	Convey("Subject: Get logs with invalid filter", t, func() {
+		requester := newLogsRequester(4)
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("When fromEpoch is greater than toEpoch", func() {
+			filter := types.LogFilter{
+				FromEpoch: types.NewEpochNumber(big.NewInt(10)),
+				ToEpoch:   types.NewEpochNumber(big.NewInt(1)),
+			}
+			_, err := client.GetLogs(filter)
+
+			Convey("Return error without requesting node", func() {
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_getLogs")), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestIterateLogs(t *testing.T) {
+
+	Convey("Subject: Iterate logs by callback", t, func() {
+		requester := newLogsRequester(2000)
+		client, _ := NewClientWithRPCRequester(requester)
+		filter := types.LogFilter{
+			FromEpoch: types.NewEpochNumber(big.NewInt(0)),
+			ToEpoch:   types.NewEpochNumber(big.NewInt(2499)),
+		}
+
+		Convey("When iterate all logs", func() {
+			var epochs []uint64
+			err := client.IterateLogs(filter, func(log types.Log) error {
+				epochs = append(epochs, log.EpochNumber.ToInt().Uint64())
+				return nil
+			})
+
+			Convey("The callback is invoked for each log in order", func() {
+				So(err, ShouldBeNil)
+				So(len(epochs), ShouldEqual, 2500)
+				for i, epoch := range epochs {
+					So(epoch, ShouldEqual, uint64(i))
+				}
+				So(len(requester.CallsOf("cfx_getLogs")), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the callback returns error", func() {
+			stop := errors.New("stop")
+			count := 0
+			err := client.IterateLogs(filter, func(log types.Log) error {
+				count++
+				if count == 10 {
+					return stop
+				}
+				return nil
+			})
+
+			Convey("Stop iterating and return the error", func() {
+				So(err, ShouldEqual, stop)
+				So(count, ShouldEqual, 10)
+				So(len(requester.CallsOf("cfx_getLogs")), ShouldEqual, 1)
+			})
+		})
+	})
+}
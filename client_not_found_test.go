@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/constants"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNotFoundAsError(t *testing.T) {
+
+	Convey("Subject: Get entities which do not exist", t, func() {
+		requester := newMinedRequester("")
+		requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Given SetNotFoundAsError is disabled by default", func() {
+
+			Convey("Return nil transaction and nil error", func() {
+				tx, err := client.GetTransactionByHash(types.Hash("0xa1"))
+				So(tx, ShouldBeNil)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Given SetNotFoundAsError is enabled", func() {
+			client.SetNotFoundAsError(true)
+
+			Convey("When get transaction and receipt", func() {
+				tx, txErr := client.GetTransactionByHash(types.Hash("0xa1"))
+				receipt, receiptErr := client.GetTransactionReceipt(types.Hash("0xa1"))
+
+				Convey("Return ErrNotFound", func() {
+					So(tx, ShouldBeNil)
+					So(errors.Is(txErr, ErrNotFound), ShouldBeTrue)
+					So(receipt, ShouldBeNil)
+					So(errors.Is(receiptErr, ErrNotFound), ShouldBeTrue)
+				})
+			})
+
+			Convey("When wait for the transaction mined until timeout", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+				_, err := client.WaitForTransactionMined(ctx, types.Hash("0xa1"))
+
+				Convey("Keep polling and return context error", func() {
+					So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+					So(len(requester.CallsOf("cfx_getTransactionByHash")), ShouldBeGreaterThanOrEqualTo, 1)
+				})
+			})
+
+			Convey("When get confirmation risk of an unknown block", func() {
+				requester := sdktest.NewMockRequester()
+				requester.OnAny("cfx_getConfirmationRiskByHash").Return(nil)
+				requester.OnAny("cfx_getBlockByHash").Return(nil)
+				client, _ := NewClientWithRPCRequester(requester)
+				client.SetNotFoundAsError(true)
+				risk, err := client.GetRawBlockConfirmationRisk(types.Hash("0xb1"))
+
+				Convey("Return the max risk as if not enabled", func() {
+					So(err, ShouldBeNil)
+					So(risk, ShouldResemble, constants.MaxUint256)
+				})
+			})
+
+			Convey("When send a signed transaction not propagated yet", func() {
+				requester.OnAny("cfx_sendRawTransaction").Return("0xa1")
+				tx := types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+				tx.Nonce = types.NewBigInt(1)
+				tx.ChainID = types.NewBigInt(1)
+				tx.GasPrice = types.NewBigInt(1)
+				tx.EpochHeight = types.NewBigInt(100)
+				tx.Gas = types.NewBigInt(21000)
+				tx.StorageLimit = types.NewBigInt(0)
+				tx.ApplyDefault()
+				encoded, err := tx.Encode()
+				So(err, ShouldBeNil)
+				respondTx, err := client.SignEncodedTransactionAndSend(encoded, 0, make([]byte, 32), make([]byte, 32))
+
+				Convey("Return nil transaction rather than ErrNotFound", func() {
+					So(err, ShouldBeNil)
+					So(respondTx, ShouldBeNil)
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package sdk
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// startProxy starts a http proxy on local port which serves the accepted connection by serve.
+func startProxy(serve func(conn net.Conn)) (*url.URL, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn)
+		}
+	}()
+
+	return &url.URL{Scheme: "http", Host: listener.Addr().String()}, func() { listener.Close() }
+}
+
+func TestClientOption(t *testing.T) {
+
+	Convey("Subject: Create http client by option", t, func() {
+
+		Convey("When transport knobs are set", func() {
+			option := ClientOption{
+				DialTimeout:         time.Second,
+				RequestTimeout:      3 * time.Second,
+				MaxConnsPerHost:     8,
+				MaxIdleConnDuration: time.Minute,
+			}
+			httpClient := option.httpClient()
+
+			Convey("The http client is configured by them", func() {
+				So(httpClient.Dial, ShouldNotBeNil)
+				So(httpClient.ReadTimeout, ShouldEqual, 3*time.Second)
+				So(httpClient.WriteTimeout, ShouldEqual, 3*time.Second)
+				So(httpClient.MaxConnsPerHost, ShouldEqual, 8)
+				So(httpClient.MaxIdleConnDuration, ShouldEqual, time.Minute)
+			})
+		})
+
+		Convey("When a proxy is set", func() {
+			targets := make(chan string, 1)
+			proxy, stop := startProxy(func(conn net.Conn) {
+				defer conn.Close()
+				req, err := http.ReadRequest(bufio.NewReader(conn))
+				if err != nil {
+					return
+				}
+				targets <- req.Method + " " + req.Host + " " + req.Header.Get("Proxy-Authorization")
+				io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+				io.Copy(conn, conn)
+			})
+			defer stop()
+			proxy.User = url.UserPassword("user", "password")
+
+			option := ClientOption{Proxy: proxy, DialTimeout: time.Second}
+			conn, err := option.httpClient().Dial("node.conflux:12537")
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			Convey("The connection is tunneled through proxy", func() {
+				So(<-targets, ShouldEqual, "CONNECT node.conflux:12537 Basic dXNlcjpwYXNzd29yZA==")
+
+				_, err := conn.Write([]byte("ping"))
+				So(err, ShouldBeNil)
+				echo := make([]byte, 4)
+				_, err = io.ReadFull(conn, echo)
+				So(err, ShouldBeNil)
+				So(string(echo), ShouldEqual, "ping")
+			})
+		})
+
+		Convey("When the proxy sends data of node along with the response of CONNECT", func() {
+			proxy, stop := startProxy(func(conn net.Conn) {
+				defer conn.Close()
+				http.ReadRequest(bufio.NewReader(conn))
+				io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\nhello")
+			})
+			defer stop()
+
+			option := ClientOption{Proxy: proxy, DialTimeout: time.Second}
+			conn, err := option.httpClient().Dial("node.conflux:12537")
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			Convey("The data is read from the connection", func() {
+				data := make([]byte, 5)
+				_, err := io.ReadFull(conn, data)
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, "hello")
+			})
+		})
+
+		Convey("When the proxy is not a http proxy", func() {
+			connected := make(chan struct{}, 1)
+			proxy, stop := startProxy(func(conn net.Conn) {
+				conn.Close()
+				connected <- struct{}{}
+			})
+			defer stop()
+			proxy.Scheme = "socks5"
+
+			option := ClientOption{Proxy: proxy}
+			_, dialErr := option.httpClient().Dial("node.conflux:12537")
+			_, err := NewClientWithOption("http://localhost:12537", option)
+
+			Convey("Return error without connecting to proxy", func() {
+				So(dialErr, ShouldNotBeNil)
+				So(err, ShouldNotBeNil)
+				So(len(connected), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the proxy refuses to connect", func() {
+			proxy, stop := startProxy(func(conn net.Conn) {
+				defer conn.Close()
+				http.ReadRequest(bufio.NewReader(conn))
+				io.WriteString(conn, "HTTP/1.1 403 Forbidden\r\nContent-Length: 0\r\n\r\n")
+			})
+			defer stop()
+
+			option := ClientOption{Proxy: proxy}
+			_, err := option.httpClient().Dial("node.conflux:12537")
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the proxy does not respond before dial timeout", func() {
+			release := make(chan struct{})
+			proxy, stop := startProxy(func(conn net.Conn) {
+				defer conn.Close()
+				<-release
+			})
+			defer stop()
+			defer close(release)
+
+			option := ClientOption{Proxy: proxy, DialTimeout: 50 * time.Millisecond}
+			start := time.Now()
+			_, err := option.httpClient().Dial("node.conflux:12537")
+
+			Convey("Return error after dial timeout", func() {
+				So(err, ShouldNotBeNil)
+				So(time.Since(start), ShouldBeLessThan, time.Second)
+			})
+		})
+
+		Convey("When a custom dial is set", func() {
+			var dialed string
+			option := ClientOption{
+				Dial: func(addr string) (net.Conn, error) {
+					dialed = addr
+					return nil, io.EOF
+				},
+				Proxy: &url.URL{Scheme: "http", Host: "127.0.0.1:1"},
+			}
+			_, err := option.httpClient().Dial("node.conflux:12537")
+
+			Convey("The custom dial is used instead of proxy", func() {
+				So(err, ShouldEqual, io.EOF)
+				So(dialed, ShouldEqual, "node.conflux:12537")
+			})
+		})
+
+		Convey("When create client with an invalid node url", func() {
+			_, err := NewClientWithOption("ftp://localhost:12537", ClientOption{})
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetAccountPendingInfo(t *testing.T) {
+
+	Convey("Subject: Get pending info of account", t, func() {
+		address := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getAccountPendingInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if args[0] != address {
+				return nil, nil
+			}
+			return map[string]interface{}{
+				"localNonce":    "0x5",
+				"pendingCount":  "0x2",
+				"pendingNonce":  "0x3",
+				"nextPendingTx": "0xa1",
+			}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Get pending info of account with pending transactions", func() {
+			info, err := client.GetAccountPendingInfo(address)
+			So(err, ShouldBeNil)
+			So(info.LocalNonce.ToInt().Int64(), ShouldEqual, 5)
+			So(info.PendingCount.ToInt().Int64(), ShouldEqual, 2)
+			So(info.PendingNonce.ToInt().Int64(), ShouldEqual, 3)
+			So(info.NextPendingTx, ShouldEqual, types.Hash("0xa1"))
+
+			calls := requester.CallsOf("cfx_getAccountPendingInfo")
+			So(len(calls), ShouldEqual, 1)
+			So(calls[0].Args, ShouldResemble, []interface{}{address})
+		})
+
+		Convey("Get pending info of account unknown by node", func() {
+			info, err := client.GetAccountPendingInfo("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+			So(err, ShouldBeNil)
+			So(info, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResendTransaction(t *testing.T) {
+
+	Convey("Subject: Resend stuck transaction", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		original := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+		original.From = &from
+		original.Nonce = types.NewBigInt(5)
+		original.ChainID = types.NewBigInt(1)
+		original.GasPrice = types.NewBigInt(10)
+		original.EpochHeight = types.NewBigInt(100)
+		original.Gas = types.NewBigInt(21000)
+		original.StorageLimit = types.NewBigInt(0)
+
+		newRequester := func(nextNonce string) *sdktest.MockRequester {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nextNonce, nil
+			})
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa2", nil
+			})
+			return requester
+		}
+
+		Convey("Given the original transaction is still pending", func() {
+			requester := newRequester("0x5")
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("When resend with a higher gas price", func() {
+				hash, err := client.ResendTransaction(original, big.NewInt(20))
+
+				Convey("Send the transaction with the same nonce and original is not modified", func() {
+					So(err, ShouldBeNil)
+					So(hash, ShouldEqual, types.Hash("0xa2"))
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+					So(original.GasPrice.ToInt().Int64(), ShouldEqual, 10)
+				})
+			})
+
+			Convey("When resend with a gas price not higher than original", func() {
+				_, err := client.ResendTransaction(original, big.NewInt(10))
+
+				Convey("Return error without sending", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+				})
+			})
+		})
+
+		Convey("Given the original transaction is already executed", func() {
+			requester := newRequester("0x6")
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+
+			Convey("When resend with a higher gas price", func() {
+				_, err := client.ResendTransaction(original, big.NewInt(20))
+
+				Convey("Return error without sending", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,212 @@
+package sdk
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newFlakyRequester returns a MockRequester whose cfx_gasPrice fails the first failures calls.
+func newFlakyRequester(failures int) *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		if len(requester.CallsOf("cfx_gasPrice")) <= failures {
+			return nil, errors.New("connection reset by peer")
+		}
+		return "0x1", nil
+	})
+	return requester
+}
+
+func TestCallRPCRetryCount(t *testing.T) {
+
+	Convey("Subject: Override retry count per call", t, func() {
+
+		Convey("Given a client retries once and a node fails twice", func() {
+			requester := newFlakyRequester(2)
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 1,
+				interval:   time.Millisecond,
+			})
+
+			Convey("When call with default retry count", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Return error after 2 attempts", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "failed after 1 retries")
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When call with retry count 3", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{RetryCount: 3}, "cfx_gasPrice")
+
+				Convey("Return result after 3 attempts", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, "0x1")
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 3)
+				})
+			})
+
+			Convey("When call with retry count 0", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{RetryCount: 0}, "cfx_gasPrice")
+
+				Convey("Return the error unwrapped after 1 attempt", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldNotContainSubstring, "retries")
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
+
+func TestRetryClassification(t *testing.T) {
+
+	Convey("Subject: Only retry transient errors", t, func() {
+
+		Convey("Given a client retries 3 times", func() {
+			requester := newFlakyRequester(1)
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32015, "Transaction reverted", nil}
+			})
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 3,
+				interval:   time.Millisecond,
+			})
+
+			Convey("When the node responds a JSON-RPC error", func() {
+				err := client.CallRPC(nil, "cfx_call")
+
+				Convey("Return the error without retry", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_call")), ShouldEqual, 1)
+				})
+			})
+
+			Convey("When the request failed by network error", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Return result after retry", func() {
+					So(err, ShouldBeNil)
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When the predicate is overridden to never retry", func() {
+				client.SetRetryableErrorPredicate(func(err error) bool { return false })
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Return the network error without retry", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("When classify errors by default predicate", func() {
+			So(IsRetryableError(errors.New("connection reset by peer")), ShouldBeTrue)
+			So(IsRetryableError(rpc.HTTPError{StatusCode: 502, Status: "502 Bad Gateway"}), ShouldBeTrue)
+			So(IsRetryableError(rpc.HTTPError{StatusCode: 400, Status: "400 Bad Request"}), ShouldBeFalse)
+			So(IsRetryableError(&types.RPCError{Code: -32000, Message: "nonce too low"}), ShouldBeFalse)
+			So(IsRetryableError(ErrClientShutdown), ShouldBeFalse)
+		})
+	})
+}
+
+func TestCallRPCWithOption(t *testing.T) {
+
+	Convey("Subject: Override retry settings per call", t, func() {
+
+		Convey("Given a client retries once and a node fails twice", func() {
+			requester := newFlakyRequester(2)
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 1,
+				interval:   time.Hour,
+			})
+			clock := newFakeClock()
+			client.SetClock(clock)
+
+			Convey("When call with nil option", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, nil, "cfx_gasPrice")
+
+				Convey("Retry with the settings of client", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Hour})
+				})
+			})
+
+			Convey("When call with retry count 2 every second", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{RetryCount: 2, RetryInterval: time.Second}, "cfx_gasPrice")
+
+				Convey("Return result after 3 attempts", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, "0x1")
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Second, time.Second})
+				})
+			})
+
+			Convey("When call with zero option", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{}, "cfx_gasPrice")
+
+				Convey("Return error without retry", func() {
+					So(err, ShouldNotBeNil)
+					So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+					So(len(clock.slept()), ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
+
+func TestSetRetrySettingsConcurrently(t *testing.T) {
+
+	Convey("Subject: Change retry settings while requests are in flight", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nil, errors.New("connection reset by peer")
+		})
+		client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+			inner:      requester,
+			retryCount: 2,
+			interval:   time.Millisecond,
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var result string
+				client.CallRPC(&result, "cfx_gasPrice")
+				client.CallRPCWithOption(&result, &CallOption{RetryCount: 1}, "cfx_gasPrice")
+			}()
+		}
+		client.SetRetryableErrorPredicate(func(err error) bool { return true })
+		client.SetClock(nil)
+		client.SetMetricsObserver(nil)
+		wg.Wait()
+
+		// run with -race to detect the unsynchronized settings
+		So(len(requester.CallsOf("cfx_gasPrice")), ShouldBeGreaterThanOrEqualTo, 8)
+	})
+}
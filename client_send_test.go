@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newSendRequester returns a MockRequester whose first cfx_sendRawTransaction times out,
+// and the transaction is received by node on timeout if reached is true.
+func newSendRequester(reached bool) *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	received := false
+	requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		raw, _ := hexutil.Decode(args[0].(string))
+		hash := hexutil.Encode(crypto.Keccak256(raw))
+		if len(requester.CallsOf("cfx_sendRawTransaction")) == 1 {
+			received = reached
+			return nil, errors.New("i/o timeout")
+		}
+		if received {
+			return nil, &fakeJSONError{-32602, "Invalid parameters: tx", "tx already exist"}
+		}
+		received = true
+		return hash, nil
+	})
+	requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		if !received {
+			return nil, nil
+		}
+		return map[string]interface{}{"hash": args[0]}, nil
+	})
+	return requester
+}
+
+func TestSendRawTransactionRetry(t *testing.T) {
+
+	Convey("Subject: Send raw transaction with retry", t, func() {
+		raw := []byte{0x01, 0x02, 0x03}
+		expectHash := types.Hash(hexutil.Encode(crypto.Keccak256(raw)))
+
+		newClient := func(requester *sdktest.MockRequester) *Client {
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 3,
+				interval:   time.Second,
+			})
+			client.SetClock(newFakeClock())
+			return client
+		}
+
+		Convey("Given the first attempt times out after node received the transaction", func() {
+			requester := newSendRequester(true)
+			client := newClient(requester)
+
+			Convey("When send raw transaction", func() {
+				hash, err := client.SendRawTransaction(raw)
+
+				Convey("Return the transaction hash without broadcasting again", func() {
+					So(err, ShouldBeNil)
+					So(hash, ShouldEqual, expectHash)
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("Given the first attempt times out before node received the transaction", func() {
+			requester := newSendRequester(false)
+			client := newClient(requester)
+
+			Convey("When send raw transaction", func() {
+				hash, err := client.SendRawTransaction(raw)
+
+				Convey("Broadcast again and return the transaction hash", func() {
+					So(err, ShouldBeNil)
+					So(hash, ShouldEqual, expectHash)
+					So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 2)
+				})
+			})
+		})
+
+		Convey("Given node responds the transaction already exists", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32602, "Invalid parameters: tx", "tx already exist"}
+			})
+			client := newClient(requester)
+
+			Convey("Return the transaction hash", func() {
+				hash, err := client.SendRawTransaction(raw)
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, expectHash)
+			})
+		})
+
+		Convey("Given a client without retry and the attempt times out", func() {
+			requester := newSendRequester(false)
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return error without retry", func() {
+				_, err := client.SendRawTransaction(raw)
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("Given node responds null or a non-string hash", func() {
+			for _, response := range []interface{}{nil, 1} {
+				requester := sdktest.NewMockRequester()
+				requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+					return response, nil
+				})
+				client, _ := NewClientWithRPCRequester(requester)
+
+				_, err := client.SendRawTransaction(raw)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
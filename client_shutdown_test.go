@@ -0,0 +1,201 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestShutdown(t *testing.T) {
+
+	Convey("Subject: Shutdown client gracefully", t, func() {
+
+		Convey("Given a client with an in-flight request", func() {
+			requester := sdktest.NewMockRequester()
+			started := make(chan struct{})
+			release := make(chan struct{})
+			requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				close(started)
+				<-release
+				return "0x1", nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			callDone := make(chan error, 1)
+			go func() {
+				_, err := client.GetGasPrice()
+				callDone <- err
+			}()
+			<-started
+
+			shutdownDone := make(chan error, 1)
+			go func() {
+				shutdownDone <- client.Shutdown(context.Background())
+			}()
+
+			Convey("Shutdown waits for the in-flight request", func() {
+				select {
+				case <-shutdownDone:
+					t.Fatal("shutdown returned before in-flight request completed")
+				case <-time.After(50 * time.Millisecond):
+				}
+
+				close(release)
+				So(<-callDone, ShouldBeNil)
+				So(<-shutdownDone, ShouldBeNil)
+				So(requester.CloseCount(), ShouldEqual, 1)
+
+				_, err := client.GetGasPrice()
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Given a client with a request not completed before deadline", func() {
+			requester := sdktest.NewMockRequester()
+			started := make(chan struct{})
+			release := make(chan struct{})
+			requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				close(started)
+				<-release
+				return "0x1", nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				client.GetGasPrice()
+			}()
+			// complete the request after the test, so that the goroutine is not leaked
+			defer func() {
+				close(release)
+				wg.Wait()
+			}()
+			<-started
+
+			Convey("Shutdown returns the context error after deadline", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+				defer cancel()
+
+				So(client.Shutdown(ctx), ShouldEqual, context.DeadlineExceeded)
+				So(requester.CloseCount(), ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+// failCloseRequester is a MockRequester which fails to close.
+type failCloseRequester struct {
+	*sdktest.MockRequester
+}
+
+func (r failCloseRequester) Close() error {
+	r.MockRequester.Close()
+	return errors.New("close of broken connection")
+}
+
+// blockingSubscriber is a MockRequester which supports subscription, and the subscribing blocks until released.
+type blockingSubscriber struct {
+	*sdktest.MockRequester
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingSubscriber) Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
+	close(r.started)
+	<-r.release
+	return nil, errors.New("subscription rejected")
+}
+
+func TestShutdownSubscribing(t *testing.T) {
+
+	Convey("Subject: Shutdown client while subscribing", t, func() {
+		requester := &blockingSubscriber{sdktest.NewMockRequester(), make(chan struct{}), make(chan struct{})}
+		client, _ := NewClientWithRPCRequester(requester)
+
+		subscribeDone := make(chan error, 1)
+		go func() {
+			_, err := client.SubscribeLogs(context.Background(), make(chan types.Log), types.LogFilter{})
+			subscribeDone <- err
+		}()
+		<-requester.started
+
+		shutdownDone := make(chan error, 1)
+		go func() {
+			shutdownDone <- client.Shutdown(context.Background())
+		}()
+
+		Convey("Shutdown waits for the subscribing", func() {
+			select {
+			case <-shutdownDone:
+				t.Fatal("shutdown returned before subscribing completed")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			close(requester.release)
+			So(<-subscribeDone, ShouldNotBeNil)
+			So(<-shutdownDone, ShouldBeNil)
+			So(requester.CloseCount(), ShouldEqual, 1)
+
+			Convey("The subscribing afterwards fails with ErrClientShutdown", func() {
+				_, err := client.SubscribeLogs(context.Background(), make(chan types.Log), types.LogFilter{})
+				So(errors.Is(err, ErrClientShutdown), ShouldBeTrue)
+
+				_, err = client.SubscribeNewHeads(context.Background(), make(chan types.BlockHeader))
+				So(errors.Is(err, ErrClientShutdown), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestClose(t *testing.T) {
+
+	Convey("Subject: Close client", t, func() {
+
+		Convey("Given a client closed twice", func() {
+			requester := sdktest.NewMockRequester()
+			client, _ := NewClientWithRPCRequester(requester)
+			So(client.Close(), ShouldBeNil)
+			So(client.Close(), ShouldBeNil)
+
+			Convey("The requester is closed only once", func() {
+				So(requester.CloseCount(), ShouldEqual, 1)
+			})
+
+			Convey("The requests afterwards fail with ErrClientShutdown", func() {
+				_, err := client.GetGasPrice()
+				So(errors.Is(err, ErrClientShutdown), ShouldBeTrue)
+			})
+
+			Convey("Shutdown afterwards returns immediately", func() {
+				So(client.Shutdown(context.Background()), ShouldBeNil)
+				So(requester.CloseCount(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("Given a requester fails to close", func() {
+			requester := failCloseRequester{sdktest.NewMockRequester()}
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return the error on every call", func() {
+				So(client.Close(), ShouldNotBeNil)
+				So(client.Close(), ShouldNotBeNil)
+				So(requester.CloseCount(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("Given a nil client or requester", func() {
+			var nilClient *Client
+			So(nilClient.Close(), ShouldBeNil)
+			So((&Client{}).Close(), ShouldBeNil)
+		})
+	})
+}
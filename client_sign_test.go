@@ -0,0 +1,173 @@
+package sdk
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/Conflux-Chain/go-conflux-sdk/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newTestAccountManager creates an AccountManager with an unlocked account in a temporary keystore directory,
+// the returned function removes the directory.
+func newTestAccountManager() (*AccountManager, types.Address, func()) {
+	keydir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		panic(err)
+	}
+
+	am := NewAccountManager(keydir)
+	address, err := am.Create("password")
+	if err != nil {
+		panic(err)
+	}
+	if err := am.Unlock(address, "password"); err != nil {
+		panic(err)
+	}
+	return am, address, func() { os.RemoveAll(keydir) }
+}
+
+func TestSignTransactionDetailed(t *testing.T) {
+
+	Convey("Subject: Sign transaction detailed", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+		client.SetAccountManager(am)
+
+		Convey("When sign a transaction with all fields set", func() {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = &from
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			tx.Value = types.NewBigInt(1000)
+
+			raw, sig, hash, err := client.SignTransactionDetailed(tx)
+
+			Convey("The hash of raw matches the returned hash", func() {
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, types.Hash(hexutil.Encode(crypto.Keccak256(raw))))
+			})
+
+			Convey("The signature recovers the sender", func() {
+				unsignedHash, err := tx.Hash()
+				So(err, ShouldBeNil)
+
+				pubKey, err := crypto.SigToPub(unsignedHash, sig.Bytes())
+				So(err, ShouldBeNil)
+				So(utils.ToCfxGeneralAddress(crypto.PubkeyToAddress(*pubKey)), ShouldEqual, from)
+			})
+		})
+
+		Convey("When sign without account manager", func() {
+			client.SetAccountManager(nil)
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = &from
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			_, _, _, err := client.SignTransactionDetailed(tx)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSignTransactionWithoutSending(t *testing.T) {
+
+	Convey("Subject: Sign transaction without broadcasting", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetAccountManager(am)
+
+		tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+		tx.From = &from
+		tx.Nonce = types.NewBigInt(1)
+		tx.ChainID = types.NewBigInt(1)
+		tx.GasPrice = types.NewBigInt(1)
+		tx.EpochHeight = types.NewBigInt(100)
+		tx.Gas = types.NewBigInt(21000)
+		tx.StorageLimit = types.NewBigInt(0)
+
+		Convey("When sign the transaction", func() {
+			raw, hash, err := client.SignTransaction(tx)
+
+			Convey("Return the raw transaction and its hash without sending", func() {
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, types.Hash(hexutil.Encode(crypto.Keccak256(raw))))
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+
+				var signed types.SignedTransaction
+				So(signed.Decode(raw), ShouldBeNil)
+				So(signed.UnsignedTransaction.Nonce.ToInt().Int64(), ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestSendTransactionWithPassphrase(t *testing.T) {
+
+	Convey("Subject: Send transaction with passphrase", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+		So(am.Lock(from), ShouldBeNil)
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetAccountManager(am)
+
+		tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+		tx.From = &from
+		tx.Nonce = types.NewBigInt(1)
+		tx.ChainID = types.NewBigInt(1)
+		tx.GasPrice = types.NewBigInt(1)
+		tx.EpochHeight = types.NewBigInt(100)
+		tx.Gas = types.NewBigInt(21000)
+		tx.StorageLimit = types.NewBigInt(0)
+
+		Convey("When send with the right passphrase", func() {
+			hash, err := client.SendTransactionWithPassphrase(tx, "password")
+
+			Convey("Send the transaction and leave the account locked", func() {
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, types.Hash("0xa1"))
+
+				_, err := am.SignTransaction(*tx)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When send with a wrong passphrase", func() {
+			_, err := client.SendTransactionWithPassphrase(tx, "wrong")
+
+			Convey("Return error without sending", func() {
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+			})
+		})
+	})
+}
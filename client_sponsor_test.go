@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetSponsorInfoAndCollateral(t *testing.T) {
+
+	Convey("Subject: Get sponsor info and collateral for storage", t, func() {
+		contract := types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+		sponsor := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getSponsorInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{
+				"sponsorForGas":               string(sponsor),
+				"sponsorForCollateral":        "0x0000000000000000000000000000000000000000",
+				"sponsorGasBound":             "0x3e8",
+				"sponsorBalanceForGas":        "0x2710",
+				"sponsorBalanceForCollateral": "0x0",
+			}, nil
+		})
+		requester.OnAny("cfx_getCollateralForStorage").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x40", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Get sponsor info of contract", func() {
+			info, err := client.GetSponsorInfo(contract, types.EpochLatestState)
+			So(err, ShouldBeNil)
+			So(info.SponsorForGas, ShouldEqual, sponsor)
+			So(info.SponsorGasBound.ToInt().Int64(), ShouldEqual, 1000)
+			So(info.SponsorBalanceForGas.ToInt().Int64(), ShouldEqual, 10000)
+			So(info.SponsorBalanceForCollateral.ToInt().Sign(), ShouldEqual, 0)
+
+			calls := requester.CallsOf("cfx_getSponsorInfo")
+			So(len(calls), ShouldEqual, 1)
+			So(calls[0].Args[0], ShouldEqual, contract)
+		})
+
+		Convey("Get collateral for storage of address", func() {
+			collateral, err := client.GetCollateralForStorage(contract)
+			So(err, ShouldBeNil)
+			So(collateral.Int64(), ShouldEqual, 64)
+			So(len(requester.CallsOf("cfx_getCollateralForStorage")[0].Args), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestCheckBalanceAgainstTransaction(t *testing.T) {
+
+	Convey("Subject: Check balance against transaction by node", t, func() {
+		account := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		contract := types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_checkBalanceAgainstTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"willPayTxFee": false, "willPayCollateral": true, "isBalanceEnough": true}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Return the typed response", func() {
+			response, err := client.CheckBalanceAgainstTransaction(account, contract, types.NewBigInt(21000),
+				types.NewBigInt(1), types.NewBigInt(64), types.EpochLatestState)
+			So(err, ShouldBeNil)
+			So(response.WillPayTxFee, ShouldBeFalse)
+			So(response.WillPayCollateral, ShouldBeTrue)
+			So(response.IsBalanceEnough, ShouldBeTrue)
+
+			args := requester.CallsOf("cfx_checkBalanceAgainstTransaction")[0].Args
+			So(len(args), ShouldEqual, 6)
+			So(args[0], ShouldEqual, account)
+			So(args[1], ShouldEqual, contract)
+			So(args[5], ShouldEqual, types.EpochLatestState)
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetStakingInfo(t *testing.T) {
+
+	Convey("Subject: Get admin and staking info", t, func() {
+		address := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getAdmin").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if args[0] == types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd") {
+				return string(address), nil
+			}
+			return nil, nil
+		})
+		requester.OnAny("cfx_getStakingBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x64", nil
+		})
+		requester.OnAny("cfx_getDepositList").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return []interface{}{
+				map[string]interface{}{"amount": "0x64", "accumulatedInterestRate": "0x2", "depositTime": "0x10"},
+			}, nil
+		})
+		requester.OnAny("cfx_getVoteList").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return []interface{}{
+				map[string]interface{}{"amount": "0x32", "unlockBlockNumber": "0x100"},
+			}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Get admin of contract", func() {
+			admin, err := client.GetAdmin("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+			So(err, ShouldBeNil)
+			So(*admin, ShouldEqual, address)
+		})
+
+		Convey("Get admin of not existed contract", func() {
+			admin, err := client.GetAdmin("0x8d5adbcaf5714924830591586f05302bf87f74be")
+			So(err, ShouldBeNil)
+			So(admin, ShouldBeNil)
+		})
+
+		Convey("Get staking balance", func() {
+			balance, err := client.GetStakingBalance(address, types.EpochLatestState)
+			So(err, ShouldBeNil)
+			So(balance.Int64(), ShouldEqual, 100)
+			So(requester.CallsOf("cfx_getStakingBalance")[0].Args[1], ShouldEqual, types.EpochLatestState)
+		})
+
+		Convey("Get deposit list", func() {
+			deposits, err := client.GetDepositList(address)
+			So(err, ShouldBeNil)
+			So(len(deposits), ShouldEqual, 1)
+			So(deposits[0].Amount.ToInt().Int64(), ShouldEqual, 100)
+			So(deposits[0].AccumulatedInterestRate.ToInt().Int64(), ShouldEqual, 2)
+			So(deposits[0].DepositTime.ToInt().Int64(), ShouldEqual, 16)
+		})
+
+		Convey("Get vote list", func() {
+			votes, err := client.GetVoteList(address)
+			So(err, ShouldBeNil)
+			So(len(votes), ShouldEqual, 1)
+			So(votes[0].Amount.ToInt().Int64(), ShouldEqual, 50)
+			So(votes[0].UnlockBlockNumber.ToInt().Int64(), ShouldEqual, 256)
+		})
+	})
+}
+
+func TestGetSupplyInfo(t *testing.T) {
+
+	Convey("Subject: Get supply info", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getSupplyInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{
+				"totalIssued":      "0x3e8",
+				"totalStaking":     "0x64",
+				"totalCollateral":  "0x20",
+				"totalCirculating": "0x384",
+			}, nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Get supply info at epoch", func() {
+			info, err := client.GetSupplyInfo(types.EpochLatestState)
+			So(err, ShouldBeNil)
+			So(info.TotalIssued.ToInt().Int64(), ShouldEqual, 1000)
+			So(info.TotalStaking.ToInt().Int64(), ShouldEqual, 100)
+			So(info.TotalCollateral.ToInt().Int64(), ShouldEqual, 32)
+			So(info.TotalCirculating.ToInt().Int64(), ShouldEqual, 900)
+
+			calls := requester.CallsOf("cfx_getSupplyInfo")
+			So(len(calls), ShouldEqual, 1)
+			So(calls[0].Args, ShouldResemble, []interface{}{types.EpochLatestState})
+		})
+
+		Convey("Get supply info without epoch", func() {
+			_, err := client.GetSupplyInfo()
+			So(err, ShouldBeNil)
+			So(len(requester.CallsOf("cfx_getSupplyInfo")[0].Args), ShouldEqual, 0)
+		})
+	})
+}
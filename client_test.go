@@ -61,3 +61,29 @@ func TestNewClient(t *testing.T) {
 	})
 
 }
+
+func TestNewClientFromRPC(t *testing.T) {
+
+	Convey("Subject: New client from existing rpc client", t, func() {
+
+		Convey("When rpc client is nil", func() {
+			client, err := NewClientFromRPC(nil)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+				So(client, ShouldBeNil)
+			})
+		})
+
+		Convey("When rpc client is given", func() {
+			rpcClient := &rpc.Client{}
+			client, err := NewClientFromRPC(rpcClient)
+
+			Convey("The rpc client is used without re-dialing", func() {
+				So(err, ShouldBeNil)
+				So(client.rpcRequester, ShouldEqual, rpcClient)
+				So(errors.Is(client.Reconnect(), ErrReconnectNotSupported), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnmarshalRPCResult(t *testing.T) {
+
+	Convey("Subject: Unmarshal raw rpc result into typed value", t, func() {
+		maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+		maxUint256Hex := "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+
+		Convey("Big numbers are decoded without precision loss", func() {
+			var info types.SupplyInfo
+			raw := json.RawMessage(`{"totalIssued":"` + maxUint256Hex + `","totalStaking":"0x1","totalCollateral":"0x0"}`)
+			So(unmarshalRPCResult(raw, &info), ShouldBeNil)
+			So(info.TotalIssued.ToInt(), ShouldResemble, maxUint256)
+			So(info.TotalStaking.ToInt().Int64(), ShouldEqual, 1)
+			So(info.TotalCirculating, ShouldBeNil)
+		})
+
+		Convey("Invalid result returns error", func() {
+			var info types.SupplyInfo
+			So(unmarshalRPCResult(json.RawMessage(`{"totalIssued":1}`), &info), ShouldNotBeNil)
+		})
+
+		Convey("Null result", func() {
+			So(isNullResult(nil), ShouldBeTrue)
+			So(isNullResult(json.RawMessage("null")), ShouldBeTrue)
+			So(isNullResult(json.RawMessage(`"0x0"`)), ShouldBeFalse)
+		})
+
+		Convey("Typed result round trips through client", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return json.RawMessage(`{"hash":"0xa1","nonce":"0x1","from":"0x1cad0b19bb29d4674531d6f115237e16afce377c",` +
+					`"value":"` + maxUint256Hex + `","gasPrice":"0x1","gas":"0x5208","data":"0x","v":"0x0","r":"0x1","s":"0x1"}`), nil
+			})
+			requester.OnAny("cfx_getSupplyInfo").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return json.RawMessage(`{"totalIssued":"` + maxUint256Hex + `","totalStaking":"0x0","totalCollateral":"0x0"}`), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			tx, err := client.GetTransactionByHash("0xa1")
+			So(err, ShouldBeNil)
+			So(tx.Value.ToInt(), ShouldResemble, maxUint256)
+
+			encoded, _ := json.Marshal(tx.Value)
+			So(string(encoded), ShouldEqual, `"`+maxUint256Hex+`"`)
+
+			info, err := client.GetSupplyInfo()
+			So(err, ShouldBeNil)
+			So(info.TotalIssued.ToInt(), ShouldResemble, maxUint256)
+		})
+
+		Convey("Null string result is handled rather than panic", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getCode").Return(nil)
+			requester.OnAny("cfx_getBestBlockHash").Return(nil)
+			client, _ := NewClientWithRPCRequester(requester)
+
+			code, err := client.GetCode("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			So(err, ShouldBeNil)
+			So(code, ShouldBeEmpty)
+
+			client.SetNotFoundAsError(true)
+			_, err = client.GetCode("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+
+			hash, err := client.GetBestBlockHash()
+			So(err, ShouldNotBeNil)
+			So(hash, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestDecodeLargeAmount(t *testing.T) {
+
+	Convey("Subject: Decode amount exceeding 2^53 drip", t, func() {
+		balance, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+		Convey("Given the node returns the amount in hex", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return hexutil.EncodeBig(balance), nil
+			})
+			requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return hexutil.EncodeBig(balance), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return the amount without precision loss", func() {
+				actual, err := client.GetBalance("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, balance)
+
+				gasPrice, err := client.GetGasPrice()
+				So(err, ShouldBeNil)
+				So(gasPrice, ShouldResemble, balance)
+			})
+		})
+
+		Convey("Given the node returns the amount as a JSON number", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return json.RawMessage(balance.String()), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Return error rather than a rounded amount", func() {
+				actual, err := client.GetBalance("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+				So(err, ShouldNotBeNil)
+				So(actual, ShouldBeNil)
+			})
+		})
+	})
+}
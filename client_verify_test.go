@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetVerifiedTransactionByHash(t *testing.T) {
+
+	Convey("Subject: Get transaction and verify its sender locally", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+		sender := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		utx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+		utx.Nonce = types.NewBigInt(1)
+		utx.GasPrice = types.NewBigInt(10)
+		utx.Gas = types.NewBigInt(21000)
+		utx.Value = types.NewBigInt(100)
+		utx.StorageLimit = types.NewBigInt(0)
+		utx.EpochHeight = types.NewBigInt(100)
+		utx.ChainID = types.NewBigInt(1)
+		utx.Data = []byte{0x01, 0x02}
+
+		signed, _ := utx.Sign(privateKey)
+		hash, _ := signed.Hash()
+		txHash := types.Hash(hexutil.Encode(hash))
+
+		newRequester := func(from types.Address) *sdktest.MockRequester {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if args[0].(types.Hash) != txHash {
+					return nil, nil
+				}
+				return map[string]interface{}{
+					"hash": txHash, "from": from, "to": utx.To, "nonce": "0x1", "gasPrice": "0xa",
+					"gas": "0x5208", "value": "0x64", "storageLimit": "0x0", "epochHeight": "0x64",
+					"chainId": "0x1", "data": "0x0102",
+					"v": hexutil.EncodeBig(big.NewInt(int64(signed.V))),
+					"r": hexutil.EncodeBig(new(big.Int).SetBytes(signed.R)),
+					"s": hexutil.EncodeBig(new(big.Int).SetBytes(signed.S)),
+				}, nil
+			})
+			return requester
+		}
+
+		Convey("Given the node reports the transaction honestly", func() {
+			client, _ := NewClientWithRPCRequester(newRequester(sender))
+
+			Convey("Return the raw transaction and matched sender", func() {
+				verified, err := client.GetVerifiedTransactionByHash(txHash)
+				So(err, ShouldBeNil)
+				raw, _ := signed.Encode()
+				So([]byte(verified.Raw), ShouldResemble, raw)
+				So(verified.RecoveredFrom, ShouldEqual, sender)
+				So(verified.FromMatched, ShouldBeTrue)
+				So(verified.HashMatched, ShouldBeTrue)
+			})
+
+			Convey("Return nil if the transaction is not found", func() {
+				verified, err := client.GetVerifiedTransactionByHash(types.Hash("0xa1"))
+				So(err, ShouldBeNil)
+				So(verified, ShouldBeNil)
+			})
+		})
+
+		Convey("Given the node reports a forged sender", func() {
+			client, _ := NewClientWithRPCRequester(newRequester(types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377d")))
+
+			Convey("Return the recovered sender which does not match", func() {
+				verified, err := client.GetVerifiedTransactionByHash(txHash)
+				So(err, ShouldBeNil)
+				So(verified.RecoveredFrom, ShouldEqual, sender)
+				So(verified.FromMatched, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,392 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newMinedRequester returns a MockRequester which answers the transaction packed in block 0xb1 of epoch 0x10
+// with specified status, the transaction is not found if status is empty.
+func newMinedRequester(status string) *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		if status == "" {
+			return nil, nil
+		}
+		return map[string]interface{}{"hash": args[0], "blockHash": "0xb1", "status": status}, nil
+	})
+	requester.OnAny("cfx_getBlockByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return map[string]interface{}{"hash": args[0], "epochNumber": "0x10"}, nil
+	})
+	return requester
+}
+
+func TestWaitForTransactionMined(t *testing.T) {
+
+	Convey("Subject: Wait for transaction mined", t, func() {
+
+		Convey("Given a transaction is executed successfully", func() {
+			client, _ := NewClientWithRPCRequester(newMinedRequester("0x0"))
+
+			Convey("When wait for it mined", func() {
+				mined, err := client.WaitForTransactionMined(context.Background(), types.Hash("0xa1"))
+
+				Convey("Return the enclosing block hash and epoch", func() {
+					So(err, ShouldBeNil)
+					So(mined.BlockHash, ShouldEqual, types.Hash("0xb1"))
+					So(mined.EpochNumber.ToInt().Uint64(), ShouldEqual, 16)
+				})
+			})
+		})
+
+		Convey("Given a transaction is packed but failed", func() {
+			client, _ := NewClientWithRPCRequester(newMinedRequester("0x1"))
+
+			Convey("When wait for it mined", func() {
+				mined, err := client.WaitForTransactionMined(context.Background(), types.Hash("0xa1"))
+
+				Convey("Return ErrTransactionFailed along with the result", func() {
+					So(errors.Is(err, ErrTransactionFailed), ShouldBeTrue)
+					So(mined.BlockHash, ShouldEqual, types.Hash("0xb1"))
+				})
+			})
+		})
+
+		Convey("Given a transaction is not packed yet", func() {
+			client, _ := NewClientWithRPCRequester(newMinedRequester(""))
+
+			Convey("When wait for it mined until timeout", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+				_, err := client.WaitForTransactionMined(ctx, types.Hash("0xa1"))
+
+				Convey("Return context error", func() {
+					So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+// newReceiptRequester returns a MockRequester which accepts raw transactions, answers the receipt in epoch 0x10
+// with specified outcome status after pending polls, and answers latestEpoch as the latest state epoch.
+func newReceiptRequester(pending int, outcomeStatus uint8, latestEpoch *int) *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return "0xa1", nil
+	})
+	polls := 0
+	requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		polls++
+		if polls <= pending {
+			return nil, nil
+		}
+		return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16, "outcomeStatus": outcomeStatus}, nil
+	})
+	requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		*latestEpoch++
+		return hexutil.EncodeUint64(uint64(*latestEpoch)), nil
+	})
+	return requester
+}
+
+func TestSendTransactionAndWait(t *testing.T) {
+
+	Convey("Subject: Send transaction and wait for receipt", t, func() {
+		am, from, cleanup := newTestAccountManager()
+		defer cleanup()
+
+		tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+		tx.From = &from
+		tx.Nonce = types.NewBigInt(1)
+		tx.ChainID = types.NewBigInt(1)
+		tx.GasPrice = types.NewBigInt(1)
+		tx.EpochHeight = types.NewBigInt(100)
+		tx.Gas = types.NewBigInt(21000)
+		tx.StorageLimit = types.NewBigInt(0)
+
+		Convey("Given the receipt is available after 2 polls", func() {
+			latestEpoch := 10
+			requester := newReceiptRequester(2, 0, &latestEpoch)
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetAccountManager(am)
+			client.SetClock(newFakeClock())
+
+			Convey("When send and wait without option", func() {
+				receipt, err := client.SendTransactionAndWait(tx)
+
+				Convey("Return the receipt", func() {
+					So(err, ShouldBeNil)
+					So(receipt.TransactionHash, ShouldEqual, types.Hash("0xa1"))
+					So(len(requester.CallsOf("cfx_getTransactionReceipt")), ShouldEqual, 3)
+				})
+			})
+
+			Convey("When send and wait for 3 confirmations", func() {
+				receipt, err := client.SendTransactionAndWait(tx, &types.TransactionWaitOption{Confirmations: 3})
+
+				Convey("Return the receipt after the latest state epoch reaches 19", func() {
+					So(err, ShouldBeNil)
+					So(receipt, ShouldNotBeNil)
+					So(latestEpoch, ShouldEqual, 19)
+				})
+			})
+		})
+
+		Convey("Given the transaction is executed but failed", func() {
+			latestEpoch := 10
+			client, _ := NewClientWithRPCRequester(newReceiptRequester(0, 1, &latestEpoch))
+			client.SetAccountManager(am)
+
+			Convey("When send and wait", func() {
+				receipt, err := client.SendTransactionAndWait(tx)
+
+				Convey("Return ErrTransactionFailed along with the receipt", func() {
+					So(errors.Is(err, ErrTransactionFailed), ShouldBeTrue)
+					So(receipt.OutcomeStatus, ShouldEqual, types.OutcomeFailed)
+				})
+			})
+		})
+	})
+}
+
+func TestGetPendingTransaction(t *testing.T) {
+
+	Convey("Subject: Get state of submitted transaction", t, func() {
+
+		Convey("Given a transaction is dropped from the transaction pool", func() {
+			client, _ := NewClientWithRPCRequester(newMinedRequester(""))
+			client.SetNotFoundAsError(true)
+
+			Convey("Return state not found without error", func() {
+				tx, state, err := client.GetPendingTransaction(types.Hash("0xa1"))
+				So(err, ShouldBeNil)
+				So(tx, ShouldBeNil)
+				So(state, ShouldEqual, types.TransactionNotFound)
+			})
+		})
+
+		Convey("Given a transaction is executed", func() {
+			client, _ := NewClientWithRPCRequester(newMinedRequester("0x0"))
+
+			Convey("Return state executed", func() {
+				state, err := client.GetTransactionState(types.Hash("0xa1"))
+				So(err, ShouldBeNil)
+				So(state, ShouldEqual, types.TransactionExecuted)
+			})
+		})
+	})
+}
+
+func TestWaitForEpochConfirmations(t *testing.T) {
+
+	Convey("Subject: Wait for epoch confirmations of transaction", t, func() {
+
+		Convey("Given the enclosing block is reverted and the transaction is executed again in a later epoch", func() {
+			latestEpoch, polls := 10, 0
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				switch polls {
+				case 1:
+					return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16}, nil
+				case 2:
+					return nil, nil
+				}
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb2", "epochNumber": 20}, nil
+			})
+			requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if args[0].(*types.Epoch).Equals(types.NewEpochNumberUint64(16)) {
+					return []string{"0xb1"}, nil
+				}
+				return []string{"0xb2"}, nil
+			})
+			requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				latestEpoch += 5
+				return hexutil.EncodeUint64(uint64(latestEpoch)), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetClock(newFakeClock())
+
+			Convey("When wait for 3 confirmations", func() {
+				depth, err := client.WaitForEpochConfirmations(context.Background(), types.Hash("0xa1"), 3)
+
+				Convey("Return the depth counted from the new epoch", func() {
+					So(err, ShouldBeNil)
+					So(depth, ShouldEqual, 5)
+					So(polls, ShouldEqual, 4)
+				})
+			})
+		})
+
+		Convey("Given the receipt is stale after the enclosing block is reverted by reorg", func() {
+			latestEpoch, polls := 21, 0
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				if polls == 1 {
+					return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16}, nil
+				}
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb2", "epochNumber": 18}, nil
+			})
+			// the pivot chain is switched, so that block 0xb1 is not in epoch 16 any more
+			requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if args[0].(*types.Epoch).Equals(types.NewEpochNumberUint64(16)) {
+					return []string{"0xb0"}, nil
+				}
+				return []string{"0xb2"}, nil
+			})
+			requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return hexutil.EncodeUint64(uint64(latestEpoch)), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetClock(newFakeClock())
+
+			Convey("When wait for 3 confirmations", func() {
+				depth, err := client.WaitForEpochConfirmations(context.Background(), types.Hash("0xa1"), 3)
+
+				Convey("Re-fetch the receipt and return the depth counted from its new epoch", func() {
+					So(err, ShouldBeNil)
+					So(depth, ShouldEqual, 3)
+					So(polls, ShouldEqual, 2)
+				})
+			})
+		})
+
+		Convey("Given the transaction is executed but failed", func() {
+			latestEpoch := 10
+			client, _ := NewClientWithRPCRequester(newReceiptRequester(0, 1, &latestEpoch))
+
+			Convey("When wait for confirmations", func() {
+				_, err := client.WaitForEpochConfirmations(context.Background(), types.Hash("0xa1"), 3)
+
+				Convey("Return ErrTransactionFailed", func() {
+					So(errors.Is(err, ErrTransactionFailed), ShouldBeTrue)
+				})
+			})
+		})
+
+		Convey("Given the transaction is never executed", func() {
+			latestEpoch := 10
+			client, _ := NewClientWithRPCRequester(newReceiptRequester(1000, 0, &latestEpoch))
+
+			Convey("When the context is cancelled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				_, err := client.WaitForEpochConfirmations(ctx, types.Hash("0xa1"), 3)
+
+				Convey("Return context error", func() {
+					So(errors.Is(err, context.Canceled), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func TestWaitForConfirmedReceipt(t *testing.T) {
+
+	Convey("Subject: Wait for receipt whose block is unlikely to be reverted", t, func() {
+
+		Convey("Given the block is executed and its risk drops below threshold after polls", func() {
+			polls := 0
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16}, nil
+			})
+			requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if polls == 1 {
+					return []string{"0xb0"}, nil
+				}
+				return []string{"0xb0", "0xb1"}, nil
+			})
+			requester.OnAny("cfx_getConfirmationRiskByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if polls == 2 {
+					return "0x8000000000000000000000000000000000000000000000000000000000000000", nil
+				}
+				return "0x0", nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetClock(newFakeClock())
+
+			Convey("When wait with max revert rate 0.01", func() {
+				receipt, err := client.WaitForConfirmedReceipt(context.Background(), types.Hash("0xa1"), 0.01)
+
+				Convey("Return the receipt once the block is executed and confirmed", func() {
+					So(err, ShouldBeNil)
+					So(receipt.BlockHash, ShouldEqual, types.Hash("0xb1"))
+					So(polls, ShouldEqual, 3)
+				})
+			})
+		})
+
+		Convey("Given the block disappears between polls and SetNotFoundAsError is enabled", func() {
+			polls := 0
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16}, nil
+			})
+			requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return []string{"0xb0", "0xb1"}, nil
+			})
+			requester.OnAny("cfx_getConfirmationRiskByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				if polls == 1 {
+					return nil, nil
+				}
+				return "0x0", nil
+			})
+			requester.OnAny("cfx_getBlockByHash").Return(nil)
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetClock(newFakeClock())
+			client.SetNotFoundAsError(true)
+
+			Convey("When wait for confirmed receipt", func() {
+				receipt, err := client.WaitForConfirmedReceipt(context.Background(), types.Hash("0xa1"), 0.01)
+
+				Convey("Keep polling until the block is confirmed", func() {
+					So(err, ShouldBeNil)
+					So(receipt.BlockHash, ShouldEqual, types.Hash("0xb1"))
+					So(polls, ShouldEqual, 2)
+				})
+			})
+		})
+
+		Convey("Given the transaction disappears after its receipt is seen", func() {
+			polls := 0
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				polls++
+				if polls > 1 {
+					return nil, nil
+				}
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16}, nil
+			})
+			requester.OnAny("cfx_getBlocksByEpoch").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return []string{"0xb0"}, nil
+			})
+			requester.OnAny("cfx_getTransactionByHash").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetClock(newFakeClock())
+
+			Convey("When wait for confirmed receipt", func() {
+				_, err := client.WaitForConfirmedReceipt(context.Background(), types.Hash("0xa1"), 0.01)
+
+				Convey("Return ReorgError", func() {
+					var reorgErr *types.ReorgError
+					So(errors.As(err, &reorgErr), ShouldBeTrue)
+					So(reorgErr.BlockHash, ShouldEqual, types.Hash("0xb1"))
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import "time"
+
+// Clock provides the time-based operations used by client, such as waiting between retries and polling.
+// Set a fake clock by Client.SetClock to advance time instantly in tests.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	Sleep(d time.Duration)
+}
+
+// realClock is the Clock backed by package time.
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+
+// clockOrDefault returns clock, or the real clock if it is nil.
+func clockOrDefault(clock Clock) Clock {
+	if clock == nil {
+		return realClock{}
+	}
+	return clock
+}
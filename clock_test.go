@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeClock is a Clock whose time only advances when Sleep or After is called, both return immediately.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1600000000, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.Sleep(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.Now()
+	return ch
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+}
+
+func (c *fakeClock) slept() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration{}, c.sleeps...)
+}
+
+func TestFakeClock(t *testing.T) {
+
+	Convey("Subject: Retry with fake clock", t, func() {
+
+		Convey("Given a client retries 3 times every hour with fake clock", func() {
+			requester := newFlakyRequester(2)
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      requester,
+				retryCount: 3,
+				interval:   time.Hour,
+			})
+			clock := newFakeClock()
+			start := clock.Now()
+			client.SetClock(clock)
+
+			Convey("When call a node fails twice", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Return result after waiting 2 intervals without real sleeping", func() {
+					So(err, ShouldBeNil)
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Hour, time.Hour})
+					So(clock.Now().Sub(start), ShouldEqual, 2*time.Hour)
+				})
+			})
+
+			Convey("When call with retry count override", func() {
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{RetryCount: 1}, "cfx_gasPrice")
+
+				Convey("The fake clock is used as well", func() {
+					So(err, ShouldNotBeNil)
+					So(clock.slept(), ShouldResemble, []time.Duration{time.Hour})
+				})
+			})
+		})
+	})
+}
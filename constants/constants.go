@@ -12,6 +12,12 @@ const (
 	CFXDecimal = 18
 )
 
+const (
+	// DefaultCollateralPerByte represents the storage collateral in Drip for each byte of storage,
+	// that is 1 CFX for 1024 bytes
+	DefaultCollateralPerByte = 976562500000000
+)
+
 const (
 	// MinGasprice represents the mininum gasprice required by conflux chain when sending transactions
 	// the value of main net is 1 Gdrip
@@ -6,7 +6,11 @@ package sdk
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/Conflux-Chain/go-conflux-sdk/types"
@@ -16,23 +20,72 @@ import (
 	etypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// ErrEventNotFound is returned when no event in the ABI of contract matches the topic of log.
+var ErrEventNotFound = errors.New("no event matches the topic")
+
+// ErrMethodNotFound is returned when no method in the ABI of contract matches the method id of calldata.
+var ErrMethodNotFound = errors.New("no method matches the method id")
+
 // Contract represents a smart contract.
 // You can conveniently create contract by Client.GetContract or Client.DeployContract.
 type Contract struct {
-	ABI     abi.ABI
+	abi     abi.ABI
 	Client  ClientOperator
 	Address *types.Address
 }
 
 // ContractDeployResult for state change notification when deploying contract
+//
+// The fields other than DoneChannel and StatusChannel are written by the deploying goroutine, and they are safe to
+// read only after receiving from DoneChannel, which happens after all fields are set.
 type ContractDeployResult struct {
-	//DoneChannel channel for notifying when contract deployed done
-	DoneChannel      <-chan struct{}
+	//DoneChannel channel for notifying when contract deployed done, it is buffered and closed after notified,
+	//so the deploying goroutine never blocks even if the channel is not received.
+	DoneChannel <-chan struct{}
+	//StatusChannel receives the intermediate status of deployment in order, it is buffered for all statuses and
+	//closed when the deployment is done, so it is optional to receive.
+	StatusChannel    <-chan ContractDeployStatus
 	TransactionHash  *types.Hash
 	Error            error
 	DeployedContract *Contract
 }
 
+// ContractDeployStatus represents the intermediate status of deploying contract
+type ContractDeployStatus int
+
+// Intermediate statuses of deploying contract.
+const (
+	// DeployStatusSubmitted means the deploying transaction is sent to the node
+	DeployStatusSubmitted ContractDeployStatus = iota
+	// DeployStatusPacked means the deploying transaction is packed in a block
+	DeployStatusPacked
+	// DeployStatusExecuted means the deploying transaction is executed successfully
+	DeployStatusExecuted
+)
+
+// String implements the fmt.Stringer interface
+func (status ContractDeployStatus) String() string {
+	switch status {
+	case DeployStatusSubmitted:
+		return "submitted"
+	case DeployStatusPacked:
+		return "packed"
+	case DeployStatusExecuted:
+		return "executed"
+	}
+	return fmt.Sprintf("ContractDeployStatus(%d)", int(status))
+}
+
+// SimulationResult is the result of simulating a contract method call by Contract.Simulate
+type SimulationResult struct {
+	// Reverted is true if the execution is reverted
+	Reverted bool
+	// RevertReason is the decoded Error(string) or Panic(uint256) of reverted execution, empty if not available
+	RevertReason string
+	// Outputs are the method outputs decoded as go values if the execution is not reverted
+	Outputs []interface{}
+}
+
 // NewContract creates contract by abi and deployed address
 func NewContract(abiJSON []byte, client ClientOperator, address *types.Address) (*Contract, error) {
 	if client == nil {
@@ -41,6 +94,142 @@ func NewContract(abiJSON []byte, client ClientOperator, address *types.Address)
 	return client.GetContract(abiJSON, address)
 }
 
+// DecodeTransactionInput decodes the calldata of transaction according to abiJSON without a deployed contract,
+// and returns the name of the invoked method and its arguments keyed by argument name.
+//
+// please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
+// get the mappings of solidity types to go types
+func DecodeTransactionInput(abiJSON []byte, data []byte) (method string, args map[string]interface{}, err error) {
+	var contractABI abi.ABI
+	if err = contractABI.UnmarshalJSON(abiJSON); err != nil {
+		msg := fmt.Sprintf("unmarshal json {%+v} to ABI error", abiJSON)
+		return "", nil, types.WrapError(err, msg)
+	}
+
+	return decodeInput(contractABI, data)
+}
+
+// DecodeInput decodes the calldata of transaction to the contract, and returns the name of the invoked method
+// and its arguments keyed by argument name. It returns ErrMethodNotFound if no method matches the method id of data.
+//
+// please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
+// get the mappings of solidity types to go types
+func (contract *Contract) DecodeInput(data []byte) (method string, args map[string]interface{}, err error) {
+	return decodeInput(contract.abi, data)
+}
+
+// ABI returns the ABI of the contract.
+func (contract *Contract) ABI() abi.ABI {
+	return contract.abi
+}
+
+// Methods returns the names of methods defined in the ABI of contract in alphabetical order.
+func (contract *Contract) Methods() []string {
+	names := make([]string, 0, len(contract.abi.Methods))
+	for name := range contract.abi.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Events returns the names of events defined in the ABI of contract in alphabetical order.
+func (contract *Contract) Events() []string {
+	names := make([]string, 0, len(contract.abi.Events))
+	for name := range contract.abi.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decodeInput finds the method by the method id of data in contractABI and unpacks its arguments.
+func decodeInput(contractABI abi.ABI, data []byte) (method string, args map[string]interface{}, err error) {
+	if len(data) < 4 {
+		return "", nil, fmt.Errorf("data %x is too short to contain a method id", data)
+	}
+
+	m, err := contractABI.MethodById(data[:4])
+	if err != nil {
+		msg := fmt.Sprintf("get method by id %x error", data[:4])
+		return "", nil, types.WrapError(ErrMethodNotFound, msg)
+	}
+
+	args = make(map[string]interface{})
+	if err = m.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		msg := fmt.Sprintf("unpack bytes {%x} to method %v inputs error", data[4:], m.Name)
+		return "", nil, types.WrapError(err, msg)
+	}
+
+	return m.Name, args, nil
+}
+
+// validateConstructorArgs checks the count and types of params against the inputs of constructor in contractABI,
+// the abiJSON is used to find out whether the constructor is declared.
+func validateConstructorArgs(abiJSON []byte, contractABI abi.ABI, params []interface{}) error {
+	if !hasConstructor(abiJSON) {
+		if len(params) > 0 {
+			return fmt.Errorf("abi has no constructor, but got %v args (%v)", len(params), typesOf(params))
+		}
+		return nil
+	}
+
+	inputs := contractABI.Constructor.Inputs
+	if len(params) != len(inputs) {
+		return fmt.Errorf("constructor expects %v args (%v), got %v (%v)",
+			len(inputs), constructorTypes(contractABI), len(params), typesOf(params))
+	}
+
+	for i, input := range inputs {
+		// tuples are packed by field names, so leave them to be checked when packing
+		if input.Type.T == abi.TupleTy || input.Type.Type == nil || params[i] == nil {
+			continue
+		}
+
+		paramType := reflect.TypeOf(params[i])
+		if !paramType.AssignableTo(input.Type.Type) {
+			return fmt.Errorf("constructor arg %v (%v) expects type %v for %v, got %v",
+				i, input.Name, input.Type.Type, input.Type.String(), paramType)
+		}
+	}
+	return nil
+}
+
+// hasConstructor returns true if the constructor is declared in abiJSON.
+func hasConstructor(abiJSON []byte) bool {
+	var fields []struct {
+		Type string
+	}
+	if err := json.Unmarshal(abiJSON, &fields); err != nil {
+		return false
+	}
+
+	for _, field := range fields {
+		if field.Type == "constructor" {
+			return true
+		}
+	}
+	return false
+}
+
+// constructorTypes returns the solidity types of constructor inputs separated by comma.
+func constructorTypes(contractABI abi.ABI) string {
+	typeNames := make([]string, len(contractABI.Constructor.Inputs))
+	for i, input := range contractABI.Constructor.Inputs {
+		typeNames[i] = input.Type.String()
+	}
+	return strings.Join(typeNames, ",")
+}
+
+// typesOf returns the go types of values separated by comma.
+func typesOf(values []interface{}) string {
+	typeNames := make([]string, len(values))
+	for i, v := range values {
+		typeNames[i] = fmt.Sprintf("%T", v)
+	}
+	return strings.Join(typeNames, ",")
+}
+
 // GetData packs the given method name to conform the ABI of the contract. Method call's data
 // will consist of method_id, args0, arg1, ... argN. Method id consists
 // of 4 bytes and arguments are all 32 bytes.
@@ -50,7 +239,7 @@ func NewContract(abiJSON []byte, client ClientOperator, address *types.Address)
 // please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
 // get the mappings of solidity types to go types
 func (contract *Contract) GetData(method string, args ...interface{}) ([]byte, error) {
-	packed, err := contract.ABI.Pack(method, args...)
+	packed, err := contract.abi.Pack(method, args...)
 	if err != nil {
 		msg := fmt.Sprintf("encode method %+v with args %+v error", method, args)
 		return nil, types.WrapError(err, msg)
@@ -59,6 +248,17 @@ func (contract *Contract) GetData(method string, args ...interface{}) ([]byte, e
 	return packed, nil
 }
 
+// GetDataHex packs the given method name and args like GetData, and returns the "0x" prefixed HEX string of data,
+// which is useful for logging or the tools expecting HEX. Use GetData for the data of types.CallRequest.
+func (contract *Contract) GetDataHex(method string, args ...interface{}) (string, error) {
+	data, err := contract.GetData(method, args...)
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(data), nil
+}
+
 // Call calls to the contract method with args and fills the excuted result to the "resultPtr".
 //
 // the resultPtr should be a pointer of the method output struct type.
@@ -66,16 +266,88 @@ func (contract *Contract) GetData(method string, args ...interface{}) ([]byte, e
 // please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
 // get the mappings of solidity types to go types
 func (contract *Contract) Call(option *types.ContractMethodCallOption, resultPtr interface{}, method string, args ...interface{}) error {
+	bytes, err := contract.call(option, method, args...)
+	if err != nil {
+		return err
+	}
+
+	err = contract.abi.Unpack(resultPtr, method, bytes)
+	if err != nil {
+		msg := fmt.Sprintf("unpack bytes {%x} to method %v output on abi %+v error", bytes, method, contract.abi)
+		return types.WrapError(err, msg)
+	}
+
+	return nil
+}
+
+// CallAndUnpack calls to the contract method with args and returns the excuted result decoded as go values,
+// whose types are inferred from the method outputs in ABI, such as *big.Int for uint256 and common.Address for address.
+//
+// please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
+// get the mappings of solidity types to go types
+func (contract *Contract) CallAndUnpack(option *types.ContractMethodCallOption, method string, args ...interface{}) ([]interface{}, error) {
+	abiMethod, ok := contract.abi.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("method %v is not found in abi", method)
+	}
+
+	bytes, err := contract.call(option, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := abiMethod.Outputs.UnpackValues(bytes)
+	if err != nil {
+		msg := fmt.Sprintf("unpack bytes {%x} to method %v outputs error", bytes, method)
+		return nil, types.WrapError(err, msg)
+	}
+	return outputs, nil
+}
+
+// Simulate executes the contract method with args by cfx_call without sending transaction, it tells whether
+// the transaction would be reverted and why before sending it, such as "ERC20: transfer amount exceeds balance".
+//
+// It returns error only if the simulation itself fails, such as network error, while the revert of execution
+// is returned in the result.
+func (contract *Contract) Simulate(option *types.ContractMethodCallOption, method string, args ...interface{}) (*SimulationResult, error) {
+	abiMethod, ok := contract.abi.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("method %v is not found in abi", method)
+	}
 
+	bytes, err := contract.call(option, method, args...)
+	if err != nil {
+		if reason, reverted := revertOf(err); reverted {
+			return &SimulationResult{Reverted: true, RevertReason: reason}, nil
+		}
+		return nil, err
+	}
+
+	outputs, err := abiMethod.Outputs.UnpackValues(bytes)
+	if err != nil {
+		msg := fmt.Sprintf("unpack bytes {%x} to method %v outputs error", bytes, method)
+		return nil, types.WrapError(err, msg)
+	}
+	return &SimulationResult{Outputs: outputs}, nil
+}
+
+// call calls to the contract method with args and returns the excuted result bytes.
+func (contract *Contract) call(option *types.ContractMethodCallOption, method string, args ...interface{}) ([]byte, error) {
 	data, err := contract.GetData(method, args...)
 	if err != nil {
 		msg := fmt.Sprintf("get data of method %+v with args %+v error", method, args)
-		return types.WrapError(err, msg)
+		return nil, types.WrapError(err, msg)
 	}
 
+	return contract.CallWithData(option, data)
+}
+
+// CallWithData calls to the contract with the already encoded data and returns the excuted result bytes,
+// the data is passed through without packing, such as the data returned by GetData or encoded by other tools.
+func (contract *Contract) CallWithData(option *types.ContractMethodCallOption, data []byte) ([]byte, error) {
 	callRequest := new(types.CallRequest)
 	callRequest.To = contract.Address
-	callRequest.Data = "0x" + hex.EncodeToString(data)
+	callRequest.Data = data
 	callRequest.FillByCallOption(option)
 
 	var epoch *types.Epoch = nil
@@ -85,33 +357,27 @@ func (contract *Contract) Call(option *types.ContractMethodCallOption, resultPtr
 	resultHexStr, err := contract.Client.Call(*callRequest, epoch)
 	if err != nil {
 		msg := fmt.Sprintf("call {%+v} at epoch %+v error", *callRequest, epoch)
-		return types.WrapError(err, msg)
+		return nil, types.WrapError(err, msg)
 	}
 
 	if len(*resultHexStr) < 2 {
-		return fmt.Errorf("call response string %v length smaller than 2", resultHexStr)
+		return nil, fmt.Errorf("call response string %v length smaller than 2", resultHexStr)
 	}
 
 	bytes, err := hex.DecodeString((*resultHexStr)[2:])
 	if err != nil {
 		msg := fmt.Sprintf("decode hex string %s to bytes error", (*resultHexStr)[2:])
-		return types.WrapError(err, msg)
-	}
-
-	err = contract.ABI.Unpack(resultPtr, method, bytes)
-	if err != nil {
-		msg := fmt.Sprintf("unpack bytes {%x} to method %v output on abi %+v error", bytes, method, contract.ABI)
-		return types.WrapError(err, msg)
+		return nil, types.WrapError(err, msg)
 	}
-
-	return nil
+	return bytes, nil
 }
 
-// SendTransaction sends a transaction to the contract method with args and returns its transaction hash
+// EstimateGasAndCollateral estimates the gas used and storage collateralized when invoking the contract method
+// with args, it is useful to preview the fee before sending transaction.
 //
 // please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
 // get the mappings of solidity types to go types
-func (contract *Contract) SendTransaction(option *types.ContractMethodSendOption, method string, args ...interface{}) (*types.Hash, error) {
+func (contract *Contract) EstimateGasAndCollateral(option *types.ContractMethodCallOption, method string, args ...interface{}) (*types.Estimate, error) {
 
 	data, err := contract.GetData(method, args...)
 	if err != nil {
@@ -119,19 +385,45 @@ func (contract *Contract) SendTransaction(option *types.ContractMethodSendOption
 		return nil, types.WrapError(err, msg)
 	}
 
-	tx := new(types.UnsignedTransaction)
-	if option != nil {
-		tx.UnsignedTransactionBase = types.UnsignedTransactionBase(*option)
+	callRequest := new(types.CallRequest)
+	callRequest.FillByCallOption(option)
+	callRequest.To = contract.Address
+	callRequest.Data = data
+
+	var epoch *types.Epoch = nil
+	if option != nil && option.Epoch != nil {
+		epoch = option.Epoch
 	}
-	tx.To = contract.Address
-	tx.Data = data
 
-	err = contract.Client.ApplyUnsignedTransactionDefault(tx)
+	estimate, err := contract.Client.EstimateGasAndCollateral(*callRequest, epoch)
 	if err != nil {
-		msg := fmt.Sprintf("apply default for tx {%+v} error", tx)
+		msg := fmt.Sprintf("estimate gas and collateral of {%+v} at epoch %+v error", *callRequest, epoch)
+		return nil, types.WrapError(err, msg)
+	}
+	return estimate, nil
+}
+
+// SendTransaction sends a transaction to the contract method with args and returns its transaction hash.
+// The non-nil fields of option override the defaults, and the nil fields are filled by the client,
+// option itself is not modified.
+//
+// please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
+// get the mappings of solidity types to go types
+func (contract *Contract) SendTransaction(option *types.ContractMethodSendOption, method string, args ...interface{}) (*types.Hash, error) {
+
+	data, err := contract.GetData(method, args...)
+	if err != nil {
+		msg := fmt.Sprintf("get data of method %+v with args %+v error", method, args)
 		return nil, types.WrapError(err, msg)
 	}
 
+	return contract.SendTransactionWithData(option, data)
+}
+
+// SendTransactionWithData sends a transaction with the already encoded data to the contract and returns its
+// transaction hash, the data is passed through without packing while the fields of option still apply.
+func (contract *Contract) SendTransactionWithData(option *types.ContractMethodSendOption, data []byte) (*types.Hash, error) {
+	tx := contract.newTransaction(option, data)
 	txhash, err := contract.Client.SendTransaction(tx)
 	if err != nil {
 		msg := fmt.Sprintf("send transaction {%+v} error", tx)
@@ -140,31 +432,98 @@ func (contract *Contract) SendTransaction(option *types.ContractMethodSendOption
 	return &txhash, nil
 }
 
+// newTransaction creates a transaction to the contract with data, the fields not set by option are left empty and
+// filled by the client when sending, so that the nonce is allocated by the nonce manager of client if set.
+func (contract *Contract) newTransaction(option *types.ContractMethodSendOption, data []byte) *types.UnsignedTransaction {
+	tx := new(types.UnsignedTransaction)
+	if option != nil {
+		tx.UnsignedTransactionBase = types.UnsignedTransactionBase(*option)
+	}
+	tx.To = contract.Address
+	tx.Data = data
+	return tx
+}
+
 // DecodeEvent unpacks a retrieved log into the provided output structure.
 //
 // please refer https://github.com/Conflux-Chain/go-conflux-sdk/blob/master/README.md to
 // get the mappings of solidity types to go types
 func (contract *Contract) DecodeEvent(out interface{}, event string, log types.LogEntry) error {
 
-	topics := make([]common.Hash, len(log.Topics))
-	for i, v := range log.Topics {
-		topics[i] = *v.ToCommonHash()
+	// the first topic is the event signature, and indexed arguments are in the subsequent topics
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("log has no topic, it could not be decoded as event %v", event)
+	}
+
+	eLog, err := toEthLog(log)
+	if err != nil {
+		return err
+	}
+
+	// non-indexed arguments are unpacked from data, and indexed ones are parsed from topics
+	return contract.boundContract().UnpackLog(out, event, eLog)
+}
+
+// DecodeEventByTopic finds the event of log by its first topic in the ABI of contract, and unpacks the log
+// into a map keyed by argument names. It returns ErrEventNotFound if no event matches the topic.
+func (contract *Contract) DecodeEventByTopic(log types.LogEntry) (eventName string, decoded map[string]interface{}, err error) {
+	if len(log.Topics) == 0 {
+		return "", nil, types.WrapError(ErrEventNotFound, "log has no topic")
+	}
+
+	event, err := contract.abi.EventByID(*log.Topics[0].ToCommonHash())
+	if err != nil {
+		msg := fmt.Sprintf("find event by topic %v error", log.Topics[0])
+		return "", nil, types.WrapError(&eventNotFoundError{err}, msg)
+	}
+
+	eLog, err := toEthLog(log)
+	if err != nil {
+		return "", nil, err
+	}
+
+	decoded = make(map[string]interface{})
+	if err = contract.boundContract().UnpackLogIntoMap(decoded, event.Name, eLog); err != nil {
+		msg := fmt.Sprintf("unpack log %+v to event %v error", log, event.Name)
+		return "", nil, types.WrapError(err, msg)
 	}
-	eLog := etypes.Log{}
-	eLog.Topics = topics
-	eLog.Data, _ = hex.DecodeString(strings.Replace(log.Data, "0x", "", -1))
-	// fmt.Printf("elog: %+v\n", eLog)
 
+	return event.Name, decoded, nil
+}
+
+// eventNotFoundError is ErrEventNotFound caused by err, so that both of them could be matched by errors.Is.
+type eventNotFoundError struct {
+	err error
+}
+
+func (e *eventNotFoundError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrEventNotFound, e.err)
+}
+
+func (e *eventNotFoundError) Is(target error) bool { return target == ErrEventNotFound }
+
+func (e *eventNotFoundError) Unwrap() error { return e.err }
+
+// boundContract returns the go-ethereum bound contract of contract, which is only used for unpacking logs.
+func (contract *Contract) boundContract() *bind.BoundContract {
 	addressPtr := new(common.Address)
 	if contract.Address != nil {
 		addressPtr = contract.Address.ToCommonAddress()
 	}
+	return bind.NewBoundContract(*addressPtr, contract.abi, nil, nil, nil)
+}
 
-	boundContract := bind.NewBoundContract(*addressPtr, contract.ABI, nil, nil, nil)
-	err := boundContract.UnpackLog(out, event, eLog)
-	if err != nil {
-		return err
+// toEthLog converts log to the go-ethereum log for unpacking by the ABI of contract.
+func toEthLog(log types.LogEntry) (etypes.Log, error) {
+	topics := make([]common.Hash, len(log.Topics))
+	for i, v := range log.Topics {
+		topics[i] = *v.ToCommonHash()
 	}
 
-	return nil
+	data, err := hex.DecodeString(strings.Replace(log.Data, "0x", "", -1))
+	if err != nil {
+		msg := fmt.Sprintf("decode log data %v error", log.Data)
+		return etypes.Log{}, types.WrapError(err, msg)
+	}
+	return etypes.Log{Topics: topics, Data: data}, nil
 }
@@ -0,0 +1,93 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// ContractCaller calls the methods of contract with a bound from address and epoch, it is created by
+// Contract.CallerAt and is safe for concurrent use.
+//
+// If the bound epoch is a tag such as types.EpochLatestState, it is resolved to the epoch number on the first call
+// and all calls afterwards read at that number, so that all reads by the caller see the same state.
+type ContractCaller struct {
+	contract *Contract
+	from     *types.Address
+
+	mu    sync.Mutex
+	epoch *types.Epoch
+}
+
+// CallerAt returns a ContractCaller which calls the methods of contract from address from at epoch, which is useful
+// for reading many values at a fixed epoch. Both from and epoch are optional, and epoch defaults to
+// types.EpochLatestState.
+func (contract *Contract) CallerAt(from *types.Address, epoch *types.Epoch) *ContractCaller {
+	if epoch == nil {
+		epoch = types.EpochLatestState
+	}
+	return &ContractCaller{contract: contract, from: from, epoch: epoch}
+}
+
+// Epoch returns the epoch at which the caller reads, the epoch tag is resolved to the epoch number on the first call.
+func (caller *ContractCaller) Epoch() (*types.Epoch, error) {
+	caller.mu.Lock()
+	defer caller.mu.Unlock()
+
+	if !isEpochTag(caller.epoch) {
+		return caller.epoch, nil
+	}
+
+	number, err := caller.contract.Client.GetEpochNumber(caller.epoch)
+	if err != nil {
+		msg := fmt.Sprintf("get epoch number of %v error", caller.epoch)
+		return nil, types.WrapError(err, msg)
+	}
+
+	caller.epoch = types.NewEpochNumber(number)
+	return caller.epoch, nil
+}
+
+// Call calls to the contract method with args at the bound epoch and fills the excuted result to the "resultPtr",
+// see Contract.Call.
+func (caller *ContractCaller) Call(resultPtr interface{}, method string, args ...interface{}) error {
+	option, err := caller.option()
+	if err != nil {
+		return err
+	}
+	return caller.contract.Call(option, resultPtr, method, args...)
+}
+
+// CallAndUnpack calls to the contract method with args at the bound epoch and returns the excuted result decoded
+// as go values, see Contract.CallAndUnpack.
+func (caller *ContractCaller) CallAndUnpack(method string, args ...interface{}) ([]interface{}, error) {
+	option, err := caller.option()
+	if err != nil {
+		return nil, err
+	}
+	return caller.contract.CallAndUnpack(option, method, args...)
+}
+
+func (caller *ContractCaller) option() (*types.ContractMethodCallOption, error) {
+	epoch, err := caller.Epoch()
+	if err != nil {
+		return nil, err
+	}
+	return &types.ContractMethodCallOption{From: caller.from, Epoch: epoch}, nil
+}
+
+// isEpochTag returns true if epoch is a named epoch, such as types.EpochLatestState.
+func isEpochTag(epoch *types.Epoch) bool {
+	for _, tag := range []*types.Epoch{types.EpochEarliest, types.EpochLatestCheckpoint, types.EpochLatestConfirmed,
+		types.EpochLatestState, types.EpochLatestMined} {
+		if epoch.Equals(tag) {
+			return true
+		}
+	}
+	return false
+}
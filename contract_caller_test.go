@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestContractCallerAt(t *testing.T) {
+
+	Convey("Subject: Call contract methods by a bound caller", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x00000000000000000000000000000000000000000000000000000000000003e8", nil
+		})
+		epochNumber := 16
+		requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			epochNumber++
+			return "0x" + big.NewInt(int64(epochNumber)).Text(16), nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		contract := newTestERC20(client)
+
+		from := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		owner := *from.ToCommonAddress()
+
+		Convey("Given a caller bound to the latest state", func() {
+			caller := contract.CallerAt(&from, types.EpochLatestState)
+
+			Convey("When call methods several times", func() {
+				var balance *big.Int
+				So(caller.Call(&balance, "balanceOf", owner), ShouldBeNil)
+				_, err := caller.CallAndUnpack("balanceOf", owner)
+				So(err, ShouldBeNil)
+
+				Convey("All calls are at the epoch number resolved on the first call", func() {
+					So(balance.Int64(), ShouldEqual, 1000)
+					So(len(requester.CallsOf("cfx_epochNumber")), ShouldEqual, 1)
+
+					calls := requester.CallsOf("cfx_call")
+					So(len(calls), ShouldEqual, 2)
+					for _, call := range calls {
+						So(*call.Args[0].(types.CallRequest).From, ShouldEqual, from)
+						So(call.Args[1].(*types.Epoch).Equals(types.NewEpochNumberUint64(17)), ShouldBeTrue)
+					}
+				})
+			})
+		})
+
+		Convey("Given a caller bound to an epoch number", func() {
+			caller := contract.CallerAt(nil, types.NewEpochNumberUint64(10))
+
+			Convey("Call at the epoch without resolving", func() {
+				_, err := caller.CallAndUnpack("totalSupply")
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_epochNumber")), ShouldEqual, 0)
+				So(requester.CallsOf("cfx_call")[0].Args[1].(*types.Epoch).Equals(types.NewEpochNumberUint64(10)), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SubscribeEvent subscribes the event of contract, decodes the received logs and delivers them to channel.
+// The element type of channel should be the struct type or pointer of struct type which DecodeEvent
+// accepts for the event. It requires a websocket or IPC connection.
+//
+// The indexedFilters are values of the indexed arguments of the event in order, nil means any value,
+// the value could also be a types.Hash which is used as topic directly.
+//
+// The subscription is re-established if the connection is lost, see Subscription.Resubscribed.
+func (contract *Contract) SubscribeEvent(ctx context.Context, channel interface{}, eventName string, indexedFilters ...interface{}) (*Subscription, error) {
+	chanVal := reflect.ValueOf(channel)
+	if chanVal.Kind() != reflect.Chan || chanVal.Type().ChanDir()&reflect.SendDir == 0 || chanVal.IsNil() {
+		return nil, errors.New("channel must be a writable channel")
+	}
+
+	elemType := chanVal.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+
+	if contract.Address == nil {
+		return nil, errors.New("contract address is empty, it is necessary for subscribing event")
+	}
+
+	topics, err := contract.eventTopics(eventName, indexedFilters...)
+	if err != nil {
+		msg := fmt.Sprintf("build topics of event %v with indexed filters %+v error", eventName, indexedFilters)
+		return nil, types.WrapError(err, msg)
+	}
+
+	filter := types.LogFilter{
+		Address: []types.Address{*contract.Address},
+		Topics:  topics,
+	}
+
+	logs := make(chan types.Log)
+	subscribe := func(ctx context.Context) (clientSubscription, error) {
+		sub, err := contract.Client.SubscribeLogs(ctx, logs, filter)
+		if err != nil {
+			return nil, err
+		}
+		return sub, nil
+	}
+
+	inner, err := subscribe(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("subscribe logs by filter {%+v} error", filter)
+		return nil, types.WrapError(err, msg)
+	}
+
+	forward := func(log types.Log, quit <-chan struct{}) error {
+		decoded := reflect.New(elemType)
+		if err := contract.DecodeEvent(decoded.Interface(), eventName, log.LogEntry); err != nil {
+			msg := fmt.Sprintf("decode log {%+v} to event %v error", log, eventName)
+			return types.WrapError(err, msg)
+		}
+
+		if !isPtr {
+			decoded = decoded.Elem()
+		}
+		reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectSend, Chan: chanVal, Send: decoded},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(quit)},
+		})
+		return nil
+	}
+
+	return newLogSubscription(inner, logs, forward, newResubscriber(contract.Client, subscribe)), nil
+}
+
+// CreateLogFilter creates the filter for querying past logs of the event of contract by GetLogs,
+// which could be decoded by DecodeEvent. The fromEpoch and toEpoch could be nil to use the defaults of node.
+//
+// The indexedArgs are values of the indexed arguments of the event in order, nil means any value,
+// the value could also be a types.Hash which is used as topic directly.
+func (contract *Contract) CreateLogFilter(eventName string, fromEpoch, toEpoch *types.Epoch, indexedArgs ...interface{}) (types.LogFilter, error) {
+	if contract.Address == nil {
+		return types.LogFilter{}, errors.New("contract address is empty, it is necessary for filtering logs")
+	}
+
+	topics, err := contract.eventTopics(eventName, indexedArgs...)
+	if err != nil {
+		msg := fmt.Sprintf("build topics of event %v with indexed args %+v error", eventName, indexedArgs)
+		return types.LogFilter{}, types.WrapError(err, msg)
+	}
+
+	return types.LogFilter{
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Address:   []types.Address{*contract.Address},
+		Topics:    topics,
+	}, nil
+}
+
+// SendAndWaitEvent sends a transaction to the contract method with args, waits for its receipt and decodes the
+// first log of event eventName emitted by the contract into out, see SendTransaction and DecodeEvent.
+//
+// The receipt is returned along with the error once it is available, ErrTransactionFailed is returned if the
+// transaction is executed but failed, and ErrEventNotFound is returned if no log of the event is found in receipt.
+func (contract *Contract) SendAndWaitEvent(option *types.ContractMethodSendOption, eventName string, out interface{}, method string, args ...interface{}) (*types.TransactionReceipt, error) {
+	event, ok := contract.abi.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %v is not found in contract abi", eventName)
+	}
+
+	if contract.Address == nil {
+		return nil, errors.New("contract address is necessary for waiting event")
+	}
+
+	data, err := contract.GetData(method, args...)
+	if err != nil {
+		msg := fmt.Sprintf("get data of method %+v with args %+v error", method, args)
+		return nil, types.WrapError(err, msg)
+	}
+
+	receipt, err := contract.Client.SendTransactionAndWait(contract.newTransaction(option, data))
+	if err != nil {
+		return receipt, err
+	}
+
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 || *log.Topics[0].ToCommonHash() != event.ID {
+			continue
+		}
+		if *log.Address.ToCommonAddress() != *contract.Address.ToCommonAddress() {
+			continue
+		}
+
+		if err := contract.DecodeEvent(out, eventName, log); err != nil {
+			msg := fmt.Sprintf("decode log %+v to event %v error", log, eventName)
+			return receipt, types.WrapError(err, msg)
+		}
+		return receipt, nil
+	}
+
+	msg := fmt.Sprintf("event %v is not emitted by transaction %v", eventName, receipt.TransactionHash)
+	return receipt, types.WrapError(ErrEventNotFound, msg)
+}
+
+// eventTopics builds the topics for filtering logs of event by values of its indexed arguments,
+// nil value means any value of the argument.
+func (contract *Contract) eventTopics(eventName string, indexedFilters ...interface{}) ([][]types.Hash, error) {
+	event, ok := contract.abi.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %v is not found in contract abi", eventName)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	if len(indexedFilters) > len(indexed) {
+		return nil, fmt.Errorf("event %v has %v indexed arguments, but got %v filters", eventName, len(indexed), len(indexedFilters))
+	}
+
+	topics := make([][]types.Hash, 0, len(indexedFilters)+1)
+	// the first topic of anonymous event is not the event signature
+	if !event.Anonymous {
+		topics = append(topics, []types.Hash{types.Hash(event.ID.Hex())})
+	}
+
+	for i, filter := range indexedFilters {
+		// a typed nil pointer, such as (*types.Address)(nil), means any value as well
+		if v := reflect.ValueOf(filter); filter == nil || (v.Kind() == reflect.Ptr && v.IsNil()) {
+			topics = append(topics, nil)
+			continue
+		}
+
+		topic, err := eventTopic(indexed[i], filter)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, []types.Hash{topic})
+	}
+
+	return topics, nil
+}
+
+// eventTopic encodes value of an indexed event argument to topic.
+func eventTopic(arg abi.Argument, value interface{}) (types.Hash, error) {
+	switch v := value.(type) {
+	case types.Hash:
+		return v, nil
+	case common.Hash:
+		return types.Hash(v.Hex()), nil
+	case types.Address:
+		value = *v.ToCommonAddress()
+	case *types.Address:
+		value = *v.ToCommonAddress()
+	}
+
+	switch arg.Type.T {
+	// the topic of dynamic type is the keccak256 hash of its value
+	case abi.StringTy:
+		str, ok := value.(string)
+		if !ok {
+			return "", fmt.Errorf("indexed argument %v expects string, got %T", arg.Name, value)
+		}
+		return types.Hash(crypto.Keccak256Hash([]byte(str)).Hex()), nil
+	case abi.BytesTy:
+		bytes, ok := value.([]byte)
+		if !ok {
+			return "", fmt.Errorf("indexed argument %v expects []byte, got %T", arg.Name, value)
+		}
+		return types.Hash(crypto.Keccak256Hash(bytes).Hex()), nil
+	case abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return "", fmt.Errorf("indexed argument %v of type %v is only supported by passing its topic as types.Hash", arg.Name, arg.Type)
+	}
+
+	// the topic of static type is its abi encoding
+	packed, err := abi.Arguments{{Type: arg.Type}}.Pack(value)
+	if err != nil {
+		msg := fmt.Sprintf("encode indexed argument %v with value %+v error", arg.Name, value)
+		return "", types.WrapError(err, msg)
+	}
+	return types.Hash(hexutil.Encode(packed)), nil
+}
@@ -0,0 +1,782 @@
+package sdk
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// erc20ABI is the abi of example/example_contract/contract/erc20.abi
+const erc20ABI = `[{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_spender","type":"address"},{"name":"_value","type":"uint256"}],"name":"approve","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"_owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"_owner","type":"address"},{"name":"_spender","type":"address"}],"name":"allowance","outputs":[{"name":"remaining","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"inputs":[{"name":"_initialAmount","type":"uint256"},{"name":"_tokenName","type":"string"},{"name":"_decimalUnits","type":"uint8"},{"name":"_tokenSymbol","type":"string"}],"payable":false,"stateMutability":"nonpayable","type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"name":"_from","type":"address"},{"indexed":true,"name":"_to","type":"address"},{"indexed":false,"name":"_value","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"_owner","type":"address"},{"indexed":true,"name":"_spender","type":"address"},{"indexed":false,"name":"_value","type":"uint256"}],"name":"Approval","type":"event"}]`
+
+func newTestERC20(client ClientOperator) *Contract {
+	contract, err := NewContract([]byte(erc20ABI), client, types.NewAddress("0x8d5adbcaf5714924830591586f05302bf87f74bd"))
+	if err != nil {
+		panic(err)
+	}
+	return contract
+}
+
+func TestEventTopics(t *testing.T) {
+
+	Convey("Subject: Build topics of event", t, func() {
+		contract := newTestERC20(nil)
+		transferSig := types.Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+		Convey("When no indexed filters", func() {
+			topics, err := contract.eventTopics("Transfer")
+
+			Convey("Return the event signature only", func() {
+				So(err, ShouldBeNil)
+				So(topics, ShouldResemble, [][]types.Hash{{transferSig}})
+			})
+		})
+
+		Convey("When filtering by the second indexed argument", func() {
+			topics, err := contract.eventTopics("Transfer", nil, types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+
+			Convey("Return nil for any and padded address", func() {
+				So(err, ShouldBeNil)
+				So(topics, ShouldResemble, [][]types.Hash{
+					{transferSig},
+					nil,
+					{types.Hash("0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c")},
+				})
+			})
+		})
+
+		Convey("When filtering by a nil address pointer", func() {
+			var from *types.Address
+			topics, err := contract.eventTopics("Transfer", from, types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+
+			Convey("Return nil for any instead of panic", func() {
+				So(err, ShouldBeNil)
+				So(topics, ShouldResemble, [][]types.Hash{
+					{transferSig},
+					nil,
+					{types.Hash("0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c")},
+				})
+			})
+		})
+
+		Convey("When too many indexed filters", func() {
+			_, err := contract.eventTopics("Transfer", nil, nil, nil)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When event not exists", func() {
+			_, err := contract.eventTopics("Mint")
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestContractSubscribeEvent(t *testing.T) {
+
+	Convey("Subject: Subscribe decoded events of contract", t, func() {
+		contractAddress := types.Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+		client, cleanup := newPubSubClient(&pubSubService{logs: []interface{}{
+			types.Log{
+				LogEntry: types.LogEntry{
+					Address: contractAddress,
+					Topics: []types.Hash{
+						"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+						"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c",
+						"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377d",
+					},
+					Data: "0x00000000000000000000000000000000000000000000000000000000000003e8",
+				},
+				EpochNumber: types.NewBigInt(16),
+			},
+		}})
+		defer cleanup()
+		contract := newTestERC20(client)
+
+		Convey("When subscribe Transfer events into a channel of struct", func() {
+			type transfer struct {
+				From  common.Address
+				To    common.Address
+				Value *big.Int
+			}
+			events := make(chan transfer)
+			sub, err := contract.SubscribeEvent(context.Background(), events, "Transfer", nil, nil)
+			So(err, ShouldBeNil)
+			defer sub.Unsubscribe()
+
+			Convey("The decoded event is delivered to the channel", func() {
+				select {
+				case event := <-events:
+					So(event.From, ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+					So(event.To, ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"))
+					So(event.Value, ShouldResemble, big.NewInt(1000))
+				case err := <-sub.Err():
+					t.Fatalf("subscription failed: %v", err)
+				case <-time.After(time.Second):
+					t.Fatal("event is not delivered")
+				}
+			})
+		})
+	})
+}
+
+func TestDecodeTransactionInput(t *testing.T) {
+
+	Convey("Subject: Decode transaction input without contract", t, func() {
+
+		Convey("When decode calldata of transfer", func() {
+			data, _ := hex.DecodeString("a9059cbb" +
+				"0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c" +
+				"00000000000000000000000000000000000000000000000000000000000003e8")
+			method, args, err := DecodeTransactionInput([]byte(erc20ABI), data)
+
+			Convey("Return method name and arguments", func() {
+				So(err, ShouldBeNil)
+				So(method, ShouldEqual, "transfer")
+				So(args["_to"], ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(args["_value"], ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When decode calldata of unknown method", func() {
+			data, _ := hex.DecodeString("12345678")
+			_, _, err := DecodeTransactionInput([]byte(erc20ABI), data)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decode too short calldata", func() {
+			_, _, err := DecodeTransactionInput([]byte(erc20ABI), []byte{0xa9})
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestConstructorArgsValidation(t *testing.T) {
+
+	Convey("Subject: Validate constructor args", t, func() {
+		var contractABI abi.ABI
+		So(contractABI.UnmarshalJSON([]byte(erc20ABI)), ShouldBeNil)
+
+		Convey("When args match the constructor", func() {
+			err := validateConstructorArgs([]byte(erc20ABI), contractABI, []interface{}{big.NewInt(100), "Test", uint8(18), "T"})
+
+			Convey("Return nil", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the arg count mismatches", func() {
+			err := validateConstructorArgs([]byte(erc20ABI), contractABI, []interface{}{big.NewInt(100), "Test"})
+
+			Convey("Return error with expected types", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "constructor expects 4 args (uint256,string,uint8,string), got 2 (*big.Int,string)")
+			})
+		})
+
+		Convey("When an arg type mismatches", func() {
+			err := validateConstructorArgs([]byte(erc20ABI), contractABI, []interface{}{big.NewInt(100), "Test", 18, "T"})
+
+			Convey("Return error of the arg", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "_decimalUnits")
+			})
+		})
+
+		Convey("When the abi has no constructor but args are provided", func() {
+			noConstructorABI := `[{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"type":"function"}]`
+			var contractABI abi.ABI
+			So(contractABI.UnmarshalJSON([]byte(noConstructorABI)), ShouldBeNil)
+
+			Convey("Return error", func() {
+				err := validateConstructorArgs([]byte(noConstructorABI), contractABI, []interface{}{"Test"})
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "abi has no constructor, but got 1 args (string)")
+			})
+
+			Convey("Return nil if no args provided", func() {
+				So(validateConstructorArgs([]byte(noConstructorABI), contractABI, nil), ShouldBeNil)
+			})
+		})
+
+		Convey("When deploy contract with mismatched arg count", func() {
+			requester := sdktest.NewMockRequester()
+			client, _ := NewClientWithRPCRequester(requester)
+			result := client.DeployContract(nil, []byte(erc20ABI), []byte{0x60, 0x80}, big.NewInt(100), "Test")
+			<-result.DoneChannel
+
+			Convey("Return error without sending transaction", func() {
+				So(result.Error, ShouldNotBeNil)
+				So(result.Error.Error(), ShouldContainSubstring, "constructor expects 4 args")
+				So(requester.CallsOf("cfx_sendRawTransaction"), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestContractEstimateGasAndCollateral(t *testing.T) {
+
+	Convey("Subject: Estimate gas and collateral of contract method", t, func() {
+		requester := newEstimateRequester()
+		client, _ := NewClientWithRPCRequester(requester)
+		contract := newTestERC20(client)
+
+		Convey("When estimate transfer at latest mined epoch", func() {
+			to := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			from := types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+			option := &types.ContractMethodCallOption{From: from, Epoch: types.EpochLatestMined}
+			estimate, err := contract.EstimateGasAndCollateral(option, "transfer", *to.ToCommonAddress(), big.NewInt(1000))
+
+			Convey("Return estimate of the request to contract with packed data", func() {
+				So(err, ShouldBeNil)
+				So(estimate.GasUsed.ToInt().Int64(), ShouldEqual, 21000)
+				So(estimate.StorageCollateralized.ToInt().Int64(), ShouldEqual, 64)
+
+				args := requester.CallsOf("cfx_estimateGasAndCollateral")[0].Args
+				request := args[0].(types.CallRequest)
+				So(*request.To, ShouldEqual, *contract.Address)
+				So(*request.From, ShouldEqual, *from)
+				So(request.Data.String(), ShouldStartWith, "0xa9059cbb")
+				So(args[1], ShouldEqual, types.EpochLatestMined)
+			})
+		})
+
+		Convey("When estimate an unknown method", func() {
+			_, err := contract.EstimateGasAndCollateral(nil, "mint", big.NewInt(1000))
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDecodeEventByTopic(t *testing.T) {
+
+	Convey("Subject: Decode event by topic", t, func() {
+		contract := newTestERC20(nil)
+
+		Convey("When decode a Transfer log", func() {
+			log := types.LogEntry{
+				Address: *contract.Address,
+				Topics: []types.Hash{
+					"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+					"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c",
+					"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377d",
+				},
+				Data: "0x00000000000000000000000000000000000000000000000000000000000003e8",
+			}
+			eventName, decoded, err := contract.DecodeEventByTopic(log)
+
+			Convey("Return event name and decoded arguments", func() {
+				So(err, ShouldBeNil)
+				So(eventName, ShouldEqual, "Transfer")
+				So(decoded["_from"], ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(decoded["_to"], ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"))
+				So(decoded["_value"], ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When decode a log of unknown event", func() {
+			log := types.LogEntry{
+				Topics: []types.Hash{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+				Data:   "0x",
+			}
+			_, _, err := contract.DecodeEventByTopic(log)
+
+			Convey("Return ErrEventNotFound along with the cause", func() {
+				So(errors.Is(err, ErrEventNotFound), ShouldBeTrue)
+				So(errors.Unwrap(errors.Unwrap(err)), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When decode a log without topic", func() {
+			_, _, err := contract.DecodeEventByTopic(types.LogEntry{Data: "0x"})
+
+			Convey("Return ErrEventNotFound", func() {
+				So(errors.Is(err, ErrEventNotFound), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestDecodeEventIndexedArgs(t *testing.T) {
+
+	Convey("Subject: Decode event with indexed arguments", t, func() {
+		contract := newTestERC20(nil)
+		log := types.LogEntry{
+			Address: *contract.Address,
+			Topics: []types.Hash{
+				"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377d",
+			},
+			Data: "0x00000000000000000000000000000000000000000000000000000000000003e8",
+		}
+
+		Convey("When decode a Transfer log into struct", func() {
+			var transfer struct {
+				From  common.Address
+				To    common.Address
+				Value *big.Int
+			}
+			err := contract.DecodeEvent(&transfer, "Transfer", log)
+
+			Convey("Indexed args are decoded from topics and others from data", func() {
+				So(err, ShouldBeNil)
+				So(transfer.From, ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(transfer.To, ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"))
+				So(transfer.Value, ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When decode a log without topics", func() {
+			var transfer struct{ Value *big.Int }
+			err := contract.DecodeEvent(&transfer, "Transfer", types.LogEntry{Data: log.Data})
+
+			Convey("Return error instead of panic", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestContractCallAndUnpack(t *testing.T) {
+
+	Convey("Subject: Call contract method and unpack outputs", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x00000000000000000000000000000000000000000000000000000000000003e8", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		contract := newTestERC20(client)
+
+		Convey("When call balanceOf", func() {
+			owner := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			outputs, err := contract.CallAndUnpack(nil, "balanceOf", *owner.ToCommonAddress())
+
+			Convey("Return outputs as go values inferred from abi", func() {
+				So(err, ShouldBeNil)
+				So(len(outputs), ShouldEqual, 1)
+				So(outputs[0], ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When call an unknown method", func() {
+			_, err := contract.CallAndUnpack(nil, "mint")
+
+			Convey("Return error without calling node", func() {
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_call")), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestCreateLogFilter(t *testing.T) {
+
+	Convey("Subject: Create log filter of event", t, func() {
+		contract := newTestERC20(nil)
+		transferSig := types.Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		owner := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		ownerTopic := types.Hash("0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c")
+
+		Convey("When create filter of Transfer to owner in epoch range", func() {
+			from, to := types.NewEpochNumber(big.NewInt(1)), types.NewEpochNumber(big.NewInt(100))
+			filter, err := contract.CreateLogFilter("Transfer", from, to, nil, owner)
+
+			Convey("Return filter of contract address, event signature and indexed args", func() {
+				So(err, ShouldBeNil)
+				So(filter.Address, ShouldResemble, []types.Address{*contract.Address})
+				So(filter.Topics, ShouldResemble, [][]types.Hash{{transferSig}, nil, {ownerTopic}})
+				So(filter.FromEpoch, ShouldEqual, from)
+				So(filter.ToEpoch, ShouldEqual, to)
+				So(filter.Validate(), ShouldBeNil)
+			})
+		})
+
+		Convey("When create filter of anonymous event", func() {
+			abiJSON := `[{"anonymous":true,"inputs":[{"indexed":true,"name":"who","type":"address"}],"name":"Ping","type":"event"}]`
+			anonymous, _ := NewContract([]byte(abiJSON), nil, contract.Address)
+			filter, err := anonymous.CreateLogFilter("Ping", nil, nil, owner)
+
+			Convey("The first topic is the indexed arg instead of event signature", func() {
+				So(err, ShouldBeNil)
+				So(filter.Topics, ShouldResemble, [][]types.Hash{{ownerTopic}})
+			})
+		})
+
+		Convey("When create filter of unknown event", func() {
+			_, err := contract.CreateLogFilter("Mint", nil, nil)
+
+			Convey("Return error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestContractWithEncodedData(t *testing.T) {
+
+	Convey("Subject: Call and send transaction to contract with encoded data", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x00000000000000000000000000000000000000000000000000000000000003e8", nil
+		})
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetSigner(NewPrivateKeySigner(privateKey))
+		contract := newTestERC20(client)
+
+		owner := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		data, _ := contract.GetData("balanceOf", *owner.ToCommonAddress())
+
+		Convey("When call with encoded data", func() {
+			result, err := contract.CallWithData(&types.ContractMethodCallOption{Epoch: types.EpochLatestState}, data)
+
+			Convey("The data is passed through and the result bytes are returned", func() {
+				So(err, ShouldBeNil)
+				So(new(big.Int).SetBytes(result).Int64(), ShouldEqual, 1000)
+
+				args := requester.CallsOf("cfx_call")[0].Args
+				request := args[0].(types.CallRequest)
+				So([]byte(request.Data), ShouldResemble, data)
+				So(args[1], ShouldEqual, types.EpochLatestState)
+			})
+		})
+
+		Convey("When send transaction with encoded data", func() {
+			option := &types.ContractMethodSendOption{
+				Nonce:        types.NewBigInt(1),
+				ChainID:      types.NewBigInt(1),
+				GasPrice:     types.NewBigInt(1),
+				EpochHeight:  types.NewBigInt(100),
+				Gas:          types.NewBigInt(50000),
+				StorageLimit: types.NewBigInt(64),
+				Value:        types.NewBigInt(10),
+			}
+			hash, err := contract.SendTransactionWithData(option, data)
+
+			Convey("The data is passed through and the option fields still apply", func() {
+				So(err, ShouldBeNil)
+				So(*hash, ShouldEqual, types.Hash("0xa1"))
+
+				raw, _ := hexutil.Decode(requester.CallsOf("cfx_sendRawTransaction")[0].Args[0].(string))
+				var tx types.SignedTransaction
+				So(tx.Decode(raw), ShouldBeNil)
+				So(tx.UnsignedTransaction.Data, ShouldResemble, data)
+				So(*tx.UnsignedTransaction.To, ShouldEqual, *contract.Address)
+				So(tx.UnsignedTransaction.Gas.ToInt().Int64(), ShouldEqual, 50000)
+				So(tx.UnsignedTransaction.StorageLimit.ToInt().Int64(), ShouldEqual, 64)
+				So(tx.UnsignedTransaction.Value.ToInt().Int64(), ShouldEqual, 10)
+			})
+		})
+	})
+}
+
+func TestContractDecodeInput(t *testing.T) {
+
+	Convey("Subject: Decode transaction input by contract", t, func() {
+		contract := newTestERC20(nil)
+		to := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		Convey("When decode calldata packed by GetData", func() {
+			data, _ := contract.GetData("approve", *to.ToCommonAddress(), big.NewInt(1000))
+			method, args, err := contract.DecodeInput(data)
+
+			Convey("Return method name and arguments", func() {
+				So(err, ShouldBeNil)
+				So(method, ShouldEqual, "approve")
+				So(args["_spender"], ShouldResemble, *to.ToCommonAddress())
+				So(args["_value"], ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When decode calldata of unknown method", func() {
+			_, _, err := contract.DecodeInput([]byte{0x12, 0x34, 0x56, 0x78})
+
+			Convey("Return ErrMethodNotFound", func() {
+				So(errors.Is(err, ErrMethodNotFound), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestContractSimulate(t *testing.T) {
+
+	Convey("Subject: Simulate contract method", t, func() {
+		revertData := "0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000014" +
+			"696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+		requester := sdktest.NewMockRequester()
+		client, _ := NewClientWithRPCRequester(requester)
+		contract := newTestERC20(client)
+		to := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		Convey("When the execution succeeds", func() {
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0x0000000000000000000000000000000000000000000000000000000000000001", nil
+			})
+			result, err := contract.Simulate(nil, "transfer", *to.ToCommonAddress(), big.NewInt(1000))
+
+			Convey("Return the outputs", func() {
+				So(err, ShouldBeNil)
+				So(result.Reverted, ShouldBeFalse)
+				So(result.Outputs, ShouldResemble, []interface{}{true})
+			})
+		})
+
+		Convey("When the execution reverts with reason", func() {
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32015, "Transaction reverted", revertData}
+			})
+			result, err := contract.Simulate(nil, "transfer", *to.ToCommonAddress(), big.NewInt(1000))
+
+			Convey("Return the revert reason", func() {
+				So(err, ShouldBeNil)
+				So(result.Reverted, ShouldBeTrue)
+				So(result.RevertReason, ShouldEqual, "insufficient balance")
+			})
+		})
+
+		Convey("When the execution panics", func() {
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32015, "Transaction reverted",
+					"0x4e487b710000000000000000000000000000000000000000000000000000000000000011"}
+			})
+			result, err := contract.Simulate(nil, "transfer", *to.ToCommonAddress(), big.NewInt(1000))
+
+			Convey("Return the panic description", func() {
+				So(err, ShouldBeNil)
+				So(result.Reverted, ShouldBeTrue)
+				So(result.RevertReason, ShouldEqual, "panic: arithmetic underflow or overflow (0x11)")
+			})
+		})
+
+		Convey("When the call fails by other error", func() {
+			requester.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, errors.New("connection refused")
+			})
+			_, err := contract.Simulate(nil, "transfer", *to.ToCommonAddress(), big.NewInt(1000))
+
+			Convey("Return the error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestContractMetadata(t *testing.T) {
+
+	Convey("Subject: Introspect contract metadata", t, func() {
+		contract := newTestERC20(nil)
+
+		Convey("Return sorted method names", func() {
+			So(contract.Methods(), ShouldResemble, []string{
+				"allowance", "approve", "balanceOf", "decimals", "name", "symbol", "totalSupply", "transfer", "transferFrom",
+			})
+		})
+
+		Convey("Return sorted event names", func() {
+			So(contract.Events(), ShouldResemble, []string{"Approval", "Transfer"})
+		})
+
+		Convey("Return the ABI", func() {
+			contractABI := contract.ABI()
+			So(len(contractABI.Constructor.Inputs), ShouldEqual, 4)
+		})
+	})
+}
+
+func TestContractGetDataHex(t *testing.T) {
+
+	Convey("Subject: Get packed data in HEX", t, func() {
+		contract := newTestERC20(nil)
+		owner := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+		Convey("Return 0x prefixed HEX of packed data", func() {
+			data, _ := contract.GetData("balanceOf", *owner.ToCommonAddress())
+			dataHex, err := contract.GetDataHex("balanceOf", *owner.ToCommonAddress())
+			So(err, ShouldBeNil)
+			So(dataHex, ShouldEqual, hexutil.Encode(data))
+			So(dataHex[:10], ShouldEqual, "0x70a08231")
+		})
+
+		Convey("Return error for unknown method", func() {
+			_, err := contract.GetDataHex("unknown")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestContractSendOptionPrecedence(t *testing.T) {
+
+	Convey("Subject: Send option fields take precedence over defaults", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+		requester := newEstimateRequester()
+		requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x1", nil
+		})
+		requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x1", nil
+		})
+		requester.OnAny("cfx_getStatus").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return map[string]interface{}{"chainId": "0x405"}, nil
+		})
+		requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x64", nil
+		})
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetSigner(NewPrivateKeySigner(privateKey))
+		contract := newTestERC20(client)
+
+		Convey("When send transaction with manually specified nonce and gas price", func() {
+			option := &types.ContractMethodSendOption{
+				Nonce:    types.NewBigInt(7),
+				GasPrice: types.NewBigInt(100),
+			}
+			to := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+			_, err := contract.SendTransaction(option, "transfer", *to.ToCommonAddress(), big.NewInt(1))
+
+			Convey("The specified fields are kept and the others are filled by defaults", func() {
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 0)
+				So(len(requester.CallsOf("cfx_gasPrice")), ShouldEqual, 0)
+
+				raw, _ := hexutil.Decode(requester.CallsOf("cfx_sendRawTransaction")[0].Args[0].(string))
+				var tx types.SignedTransaction
+				So(tx.Decode(raw), ShouldBeNil)
+				So(tx.UnsignedTransaction.Nonce.ToInt().Int64(), ShouldEqual, 7)
+				So(tx.UnsignedTransaction.GasPrice.ToInt().Int64(), ShouldEqual, 100)
+				So(tx.UnsignedTransaction.ChainID.ToInt().Int64(), ShouldEqual, 1029)
+				So(tx.UnsignedTransaction.EpochHeight.ToInt().Int64(), ShouldEqual, 100)
+				So(tx.UnsignedTransaction.Gas.ToInt().Int64(), ShouldBeGreaterThanOrEqualTo, 21000)
+			})
+
+			Convey("The option is not modified", func() {
+				So(option.ChainID, ShouldBeNil)
+				So(option.Gas, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestContractSendAndWaitEvent(t *testing.T) {
+
+	Convey("Subject: Send transaction to contract and wait for event", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+		contract := newTestERC20(nil)
+		transferLog := map[string]interface{}{
+			"address": *contract.Address,
+			"topics": []string{
+				"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377d",
+			},
+			"data": "0x00000000000000000000000000000000000000000000000000000000000003e8",
+		}
+		otherLog := map[string]interface{}{
+			"address": *contract.Address,
+			"topics": []string{
+				"0x0000000000000000000000000000000000000000000000000000000000000001",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c",
+				"0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377d",
+			},
+			"data": "0x00000000000000000000000000000000000000000000000000000000000003e8",
+		}
+
+		newClient := func(logs ...interface{}) *Client {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0xa1", nil
+			})
+			requester.OnAny("cfx_getTransactionReceipt").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return map[string]interface{}{"transactionHash": args[0], "blockHash": "0xb1", "epochNumber": 16, "outcomeStatus": 0, "logs": logs}, nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetSigner(NewPrivateKeySigner(privateKey))
+			client.SetClock(newFakeClock())
+			return client
+		}
+
+		option := &types.ContractMethodSendOption{
+			Nonce:        types.NewBigInt(1),
+			ChainID:      types.NewBigInt(1),
+			GasPrice:     types.NewBigInt(1),
+			EpochHeight:  types.NewBigInt(100),
+			Gas:          types.NewBigInt(50000),
+			StorageLimit: types.NewBigInt(64),
+		}
+		to := common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+		var transfer struct {
+			From  common.Address
+			To    common.Address
+			Value *big.Int
+		}
+
+		Convey("When the event is emitted after other events", func() {
+			contract.Client = newClient(otherLog, transferLog)
+			receipt, err := contract.SendAndWaitEvent(option, "Transfer", &transfer, "transfer", to, big.NewInt(1000))
+
+			Convey("The matched log is decoded into out", func() {
+				So(err, ShouldBeNil)
+				So(receipt.TransactionHash, ShouldEqual, types.Hash("0xa1"))
+				So(transfer.From, ShouldResemble, common.HexToAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(transfer.To, ShouldResemble, to)
+				So(transfer.Value, ShouldResemble, big.NewInt(1000))
+			})
+		})
+
+		Convey("When the event is not emitted", func() {
+			contract.Client = newClient(otherLog)
+			receipt, err := contract.SendAndWaitEvent(option, "Transfer", &transfer, "transfer", to, big.NewInt(1000))
+
+			Convey("Return ErrEventNotFound along with the receipt", func() {
+				So(errors.Is(err, ErrEventNotFound), ShouldBeTrue)
+				So(receipt, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the event is unknown", func() {
+			contract.Client = newClient(transferLog)
+			_, err := contract.SendAndWaitEvent(option, "Unknown", &transfer, "transfer", to, big.NewInt(1000))
+
+			Convey("Return error without sending transaction", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
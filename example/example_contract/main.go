@@ -10,6 +10,7 @@ import (
 	sdk "github.com/Conflux-Chain/go-conflux-sdk"
 	"github.com/Conflux-Chain/go-conflux-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 func main() {
@@ -49,13 +50,11 @@ func main() {
 		panic(err)
 	}
 
-	result := client.DeployContract(nil, abi, bytecode, big.NewInt(100000), "biu", uint8(10), "BIU")
-	_ = <-result.DoneChannel
-	if result.Error != nil {
-		panic(result.Error)
+	contract, txhash, err := client.DeployContractSync(nil, abi, bytecode, big.NewInt(100000), "biu", uint8(10), "BIU")
+	if err != nil {
+		panic(err)
 	}
-	contract = result.DeployedContract
-	fmt.Printf("deploy contract by client.DeployContract done\ncontract address: %+v\ntxhash:%v\n\n", contract.Address, result.TransactionHash)
+	fmt.Printf("deploy contract by client.DeployContractSync done\ncontract address: %+v\ntxhash:%v\n\n", contract.Address, txhash)
 
 	time.Sleep(10 * time.Second)
 
@@ -67,12 +66,15 @@ func main() {
 	// }
 
 	//get data for send/call contract method
-	user := types.Address("0x19f4bcf113e0b896d9b34294fd3da86b4adf0302")
+	user, err := types.ParseAddress("0x19f4bcf113e0b896d9b34294fd3da86b4adf0302")
+	if err != nil {
+		panic(err)
+	}
 	data, err := contract.GetData("balanceOf", user.ToCommonAddress())
 	if err != nil {
 		panic(err)
 	}
-	fmt.Printf("get data of method balanceOf result: 0x%x\n\n", data)
+	fmt.Printf("get data of method balanceOf result: %v\n\n", hexutil.Bytes(data))
 
 	//call contract method
 	//Note: the output struct type need match method output type of ABI, go type "*big.Int" match abi type "uint256", go type "struct{Balance *big.Int}" match abi tuple type "(balance uint256)"
@@ -84,7 +86,10 @@ func main() {
 	fmt.Printf("balance of address %v in contract is: %+v\n\n", user, balance)
 
 	//send transction for contract method
-	to := types.Address("0x160ebef20c1f739957bf9eecd040bce699cc42c6")
+	to, err := types.ParseAddress("0x160ebef20c1f739957bf9eecd040bce699cc42c6")
+	if err != nil {
+		panic(err)
+	}
 	txhash, err := contract.SendTransaction(nil, "transfer", to.ToCommonAddress(), big.NewInt(10))
 	if err != nil {
 		panic(err)
@@ -0,0 +1,268 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// Default values of the endpoint health options of ClientOption.
+const (
+	defaultMaxEndpointFailures     = 3
+	defaultEndpointRecoverInterval = 30 * time.Second
+)
+
+// NewClientWithEndpoints creates a new instance of Client which fails over across several conflux nodes.
+//
+// Requests are sent to the first healthy endpoint in the order of nodeURLs, and a request failed by a retryable
+// error, such as a network error, is sent to the next endpoint immediately. An endpoint is marked unhealthy after
+// option.MaxEndpointFailures consecutive failures and skipped for option.EndpointRecoverInterval, after which requests
+// are routed to it again as a health check, it becomes healthy on success or unhealthy again on failure.
+// If all endpoints are unhealthy, all of them are tried in order. The health is checked passively by the requests
+// of client, no probe request is sent to the endpoints in background.
+//
+// The predicate set by Client.SetRetryableErrorPredicate decides failover as well as retry.
+//
+// It returns error if any of nodeURLs fails to dial.
+func NewClientWithEndpoints(nodeURLs []string, option ClientOption) (*Client, error) {
+	if len(nodeURLs) == 0 {
+		return nil, errors.New("no node url specified")
+	}
+
+	failover := &failoverRequester{
+		maxFailures:     option.MaxEndpointFailures,
+		recoverInterval: option.EndpointRecoverInterval,
+	}
+	if failover.maxFailures <= 0 {
+		failover.maxFailures = defaultMaxEndpointFailures
+	}
+	if failover.recoverInterval <= 0 {
+		failover.recoverInterval = defaultEndpointRecoverInterval
+	}
+
+	for _, nodeURL := range nodeURLs {
+		rpcClient, err := dialNode(nodeURL, &option)
+		if err != nil {
+			failover.Close()
+			msg := fmt.Sprintf("dial endpoint %v error", nodeURL)
+			return nil, types.WrapError(err, msg)
+		}
+		failover.endpoints = append(failover.endpoints, &endpoint{url: nodeURL, requester: rpcClient})
+	}
+
+	client := &Client{
+		nodeURL:      strings.Join(nodeURLs, ","),
+		rpcRequester: option.withRetry(failover),
+	}
+	return client, nil
+}
+
+// endpoint is a conflux node of failoverRequester along with its health.
+type endpoint struct {
+	url       string
+	requester RPCRequester
+	// failures is the number of consecutive failures
+	failures int
+	// unhealthyUntil is the time until which the endpoint is skipped
+	unhealthyUntil time.Time
+}
+
+// failoverRequester is an RPCRequester which routes requests to the first healthy endpoint,
+// and fails over to the next endpoint if the request failed by a retryable error.
+type failoverRequester struct {
+	// redialMu serializes redials, while mu guards the fields
+	redialMu        sync.Mutex
+	mu              sync.Mutex
+	endpoints       []*endpoint
+	maxFailures     int
+	recoverInterval time.Duration
+	clock           Clock
+	// isRetryable reports whether the failed request should be sent to the next endpoint, IsRetryableError is used if nil.
+	isRetryable func(err error) bool
+	// current is the endpoint which served the last request, and failovers counts the times it changed
+	current   *endpoint
+	failovers uint64
+}
+
+func (f *failoverRequester) setClock(clock Clock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.clock = clock
+}
+
+func (f *failoverRequester) setRetryableErrorPredicate(isRetryable func(err error) bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.isRetryable = isRetryable
+}
+
+func (f *failoverRequester) retryable(err error) bool {
+	f.mu.Lock()
+	isRetryable := f.isRetryable
+	f.mu.Unlock()
+
+	if isRetryable != nil {
+		return isRetryable(err)
+	}
+	return IsRetryableError(err)
+}
+
+// candidates returns the healthy endpoints in order, or all endpoints if none is healthy.
+func (f *failoverRequester) candidates() []*endpoint {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := clockOrDefault(f.clock).Now()
+	var healthy []*endpoint
+	for _, e := range f.endpoints {
+		if !now.Before(e.unhealthyUntil) {
+			healthy = append(healthy, e)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return append([]*endpoint{}, f.endpoints...)
+	}
+	return healthy
+}
+
+// report updates the health of e by the result of request.
+func (f *failoverRequester) report(e *endpoint, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		e.failures = 0
+		e.unhealthyUntil = time.Time{}
+		if f.current != e {
+			if f.current != nil {
+				f.failovers++
+			}
+			f.current = e
+		}
+		return
+	}
+
+	e.failures++
+	if e.failures >= f.maxFailures {
+		e.unhealthyUntil = clockOrDefault(f.clock).Now().Add(f.recoverInterval)
+	}
+}
+
+// do sends the request by send to the candidate endpoints in order until it succeeds
+// or fails by a non-retryable error.
+func (f *failoverRequester) do(send func(requester RPCRequester) error) error {
+	var err error
+	for _, e := range f.candidates() {
+		err = toRPCError(send(e.requester))
+		if err != nil && f.retryable(err) {
+			f.report(e, err)
+			continue
+		}
+
+		// the endpoint is alive even if it responds a JSON-RPC error
+		f.report(e, nil)
+		return err
+	}
+	return err
+}
+
+func (f *failoverRequester) Call(resultPtr interface{}, method string, args ...interface{}) error {
+	return f.do(func(requester RPCRequester) error {
+		return requester.Call(resultPtr, method, args...)
+	})
+}
+
+func (f *failoverRequester) BatchCall(b []rpc.BatchElem) error {
+	return f.do(func(requester RPCRequester) error {
+		return requester.BatchCall(b)
+	})
+}
+
+func (f *failoverRequester) Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
+	var sub *rpc.ClientSubscription
+	err := f.do(func(requester RPCRequester) error {
+		subscriber, ok := requester.(rpcSubscriber)
+		if !ok {
+			return errors.New("subscription is not supported by the inner rpc requester")
+		}
+
+		var err error
+		sub, err = subscriber.Subscribe(ctx, namespace, channel, args...)
+		return err
+	})
+	return sub, err
+}
+
+// Redial re-dials all endpoints, and returns the errors of all endpoints failed to re-dial.
+func (f *failoverRequester) Redial() error {
+	f.redialMu.Lock()
+	defer f.redialMu.Unlock()
+	return f.redial()
+}
+
+func (f *failoverRequester) redialUnlessSwitched(switches uint64) error {
+	f.redialMu.Lock()
+	defer f.redialMu.Unlock()
+
+	if f.switches() != switches {
+		return nil
+	}
+	return f.redial()
+}
+
+// redial re-dials all endpoints, it must be called with redialMu held.
+func (f *failoverRequester) redial() error {
+	var msgs []string
+	for _, e := range f.endpoints {
+		if dialer, ok := e.requester.(redialer); ok {
+			if err := dialer.Redial(); err != nil {
+				msgs = append(msgs, fmt.Sprintf("redial endpoint %v error: %v", e.url, err))
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// switches returns the times the serving endpoint changed plus the times the endpoints are re-dialed.
+func (f *failoverRequester) switches() uint64 {
+	f.mu.Lock()
+	count := f.failovers
+	f.mu.Unlock()
+
+	for _, e := range f.endpoints {
+		if s, ok := e.requester.(connectionSwitcher); ok {
+			count += s.switches()
+		}
+	}
+	return count
+}
+
+// Close closes all endpoints, and returns the errors of all endpoints failed to close.
+func (f *failoverRequester) Close() error {
+	var msgs []string
+	for _, e := range f.endpoints {
+		if err := e.requester.Close(); err != nil {
+			msgs = append(msgs, fmt.Sprintf("close endpoint %v error: %v", e.url, err))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return nil
+}
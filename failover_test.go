@@ -0,0 +1,158 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFailoverRequester(t *testing.T) {
+
+	Convey("Subject: Fail over across endpoints", t, func() {
+		primary, secondary := newFlakyRequester(100), newFlakyRequester(0)
+		failover := &failoverRequester{
+			endpoints: []*endpoint{
+				{url: "http://primary", requester: primary},
+				{url: "http://secondary", requester: secondary},
+			},
+			maxFailures:     2,
+			recoverInterval: time.Minute,
+		}
+		client, _ := NewClientWithRPCRequester(failover)
+		clock := newFakeClock()
+		client.SetClock(clock)
+
+		Convey("When the primary endpoint is down", func() {
+			var result string
+			err := client.CallRPC(&result, "cfx_gasPrice")
+
+			Convey("The request is routed to the secondary endpoint", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldEqual, "0x1")
+				So(len(primary.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+				So(len(secondary.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+			})
+
+			Convey("The primary endpoint is skipped after max failures", func() {
+				client.CallRPC(&result, "cfx_gasPrice")
+				client.CallRPC(&result, "cfx_gasPrice")
+
+				So(len(primary.CallsOf("cfx_gasPrice")), ShouldEqual, 2)
+				So(len(secondary.CallsOf("cfx_gasPrice")), ShouldEqual, 3)
+			})
+
+			Convey("The primary endpoint is checked again after recover interval", func() {
+				client.CallRPC(&result, "cfx_gasPrice")
+				clock.Sleep(time.Minute)
+				primary.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+					return "0x2", nil
+				})
+
+				err := client.CallRPC(&result, "cfx_gasPrice")
+				So(err, ShouldBeNil)
+				So(result, ShouldEqual, "0x2")
+				So(failover.endpoints[0].failures, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the primary endpoint responds a JSON-RPC error", func() {
+			primary.OnAny("cfx_call").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, &fakeJSONError{-32015, "Transaction reverted", nil}
+			})
+			err := client.CallRPC(nil, "cfx_call")
+
+			Convey("Return the error without failover", func() {
+				So(err, ShouldNotBeNil)
+				So(len(secondary.CallsOf("cfx_call")), ShouldEqual, 0)
+				So(failover.endpoints[0].failures, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When all endpoints are down", func() {
+			secondary.OnAny("cfx_gasPrice").ReturnError(errors.New("connection reset by peer"))
+			var result string
+			for i := 0; i < 3; i++ {
+				client.CallRPC(&result, "cfx_gasPrice")
+			}
+			err := client.CallRPC(&result, "cfx_gasPrice")
+
+			Convey("All endpoints are still tried", func() {
+				So(err, ShouldNotBeNil)
+				So(len(primary.CallsOf("cfx_gasPrice")), ShouldEqual, 4)
+				So(len(secondary.CallsOf("cfx_gasPrice")), ShouldEqual, 4)
+			})
+		})
+	})
+
+	Convey("Subject: Fail over by the retryable error predicate of client", t, func() {
+		primary, secondary := newFlakyRequester(100), newFlakyRequester(0)
+		client, _ := NewClientWithRPCRequester(&failoverRequester{
+			endpoints: []*endpoint{
+				{url: "http://primary", requester: primary},
+				{url: "http://secondary", requester: secondary},
+			},
+			maxFailures:     2,
+			recoverInterval: time.Minute,
+		})
+		client.SetRetryableErrorPredicate(func(err error) bool { return false })
+
+		var result string
+		err := client.CallRPC(&result, "cfx_gasPrice")
+
+		Convey("The request is not sent to the next endpoint", func() {
+			So(err, ShouldNotBeNil)
+			So(len(primary.CallsOf("cfx_gasPrice")), ShouldEqual, 1)
+			So(len(secondary.CallsOf("cfx_gasPrice")), ShouldEqual, 0)
+		})
+	})
+
+	Convey("Subject: Redial all endpoints", t, func() {
+		dials := map[string]int{}
+		newEndpoint := func(url string, redialErr error) *endpoint {
+			requester, _ := newRedialingRequester(func() (RPCRequester, error) {
+				dials[url]++
+				if dials[url] > 1 && redialErr != nil {
+					return nil, redialErr
+				}
+				return sdktest.NewMockRequester(), nil
+			})
+			return &endpoint{url: url, requester: requester}
+		}
+		failover := &failoverRequester{
+			endpoints: []*endpoint{
+				newEndpoint("http://primary", errors.New("connection refused")),
+				newEndpoint("http://secondary", nil),
+				newEndpoint("http://tertiary", errors.New("no such host")),
+			},
+		}
+
+		err := failover.Redial()
+
+		Convey("Every endpoint is redialed and all errors are returned", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "http://primary")
+			So(err.Error(), ShouldContainSubstring, "http://tertiary")
+			So(dials["http://primary"], ShouldEqual, 2)
+			So(dials["http://secondary"], ShouldEqual, 2)
+			So(dials["http://tertiary"], ShouldEqual, 2)
+		})
+	})
+
+	Convey("Subject: Create client with endpoints", t, func() {
+
+		Convey("Return error if no node url specified", func() {
+			_, err := NewClientWithEndpoints(nil, ClientOption{})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Create client with http endpoints", func() {
+			client, err := NewClientWithEndpoints([]string{"http://localhost:12537", "http://localhost:12538"}, ClientOption{RetryCount: 1})
+			So(err, ShouldBeNil)
+			So(client.GetNodeURL(), ShouldEqual, "http://localhost:12537,http://localhost:12538")
+			client.Close()
+		})
+	})
+}
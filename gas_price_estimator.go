@@ -0,0 +1,181 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// GasPriceTier represents the priority of a transaction to be packed.
+type GasPriceTier int
+
+// Gas price tiers from the cheapest to the fastest.
+const (
+	GasPriceSlow GasPriceTier = iota
+	GasPriceStandard
+	GasPriceFast
+)
+
+// String implements the fmt.Stringer interface
+func (tier GasPriceTier) String() string {
+	switch tier {
+	case GasPriceSlow:
+		return "slow"
+	case GasPriceStandard:
+		return "standard"
+	case GasPriceFast:
+		return "fast"
+	}
+	return fmt.Sprintf("GasPriceTier(%d)", int(tier))
+}
+
+// GasPriceEstimator estimates the gas price of transaction for the given tier.
+// Set it to client by Client.SetGasPriceEstimator.
+type GasPriceEstimator interface {
+	EstimateGasPrice(client ClientOperator, tier GasPriceTier) (*big.Int, error)
+}
+
+// MultiplierGasPriceEstimator estimates gas price by multiplying the gas price responded by cfx_gasPrice
+// with the multiplier of tier, and then adding the premium.
+type MultiplierGasPriceEstimator struct {
+	Multipliers map[GasPriceTier]float64
+	Premium     *big.Int
+}
+
+// NewMultiplierGasPriceEstimator creates a MultiplierGasPriceEstimator with multipliers of slow, standard and fast tiers.
+func NewMultiplierGasPriceEstimator(slow, standard, fast float64) *MultiplierGasPriceEstimator {
+	return &MultiplierGasPriceEstimator{
+		Multipliers: map[GasPriceTier]float64{
+			GasPriceSlow:     slow,
+			GasPriceStandard: standard,
+			GasPriceFast:     fast,
+		},
+	}
+}
+
+// EstimateGasPrice implements the GasPriceEstimator interface,
+// the gas price is not multiplied if no multiplier is specified for tier.
+func (e *MultiplierGasPriceEstimator) EstimateGasPrice(client ClientOperator, tier GasPriceTier) (*big.Int, error) {
+	gasPrice, err := client.GetGasPrice()
+	if err != nil {
+		return nil, types.WrapError(err, "get gas price error")
+	}
+
+	if multiplier, ok := e.Multipliers[tier]; ok {
+		gasPrice, _ = new(big.Float).Mul(new(big.Float).SetInt(gasPrice), big.NewFloat(multiplier)).Int(nil)
+	}
+
+	if e.Premium != nil {
+		gasPrice = new(big.Int).Add(gasPrice, e.Premium)
+	}
+	return gasPrice, nil
+}
+
+// SamplingGasPriceEstimator estimates gas price by sampling the gas prices of transactions packed in
+// the pivot blocks of recent epochs. The 25th, 50th and 75th percentiles of sampled gas prices are used for
+// slow, standard and fast tiers, and the gas price responded by cfx_gasPrice is used if no transaction sampled.
+type SamplingGasPriceEstimator struct {
+	Epochs uint64
+}
+
+// NewSamplingGasPriceEstimator creates a SamplingGasPriceEstimator which samples the recent epochs.
+func NewSamplingGasPriceEstimator(epochs uint64) *SamplingGasPriceEstimator {
+	return &SamplingGasPriceEstimator{Epochs: epochs}
+}
+
+// EstimateGasPrice implements the GasPriceEstimator interface
+func (e *SamplingGasPriceEstimator) EstimateGasPrice(client ClientOperator, tier GasPriceTier) (*big.Int, error) {
+	percentile := 50
+	switch tier {
+	case GasPriceSlow:
+		percentile = 25
+	case GasPriceFast:
+		percentile = 75
+	}
+
+	gasPrices, err := client.GetGasPricePercentiles(e.Epochs, percentile)
+	if err != nil {
+		msg := fmt.Sprintf("get %vth percentile of gas prices in recent %v epochs error", percentile, e.Epochs)
+		return nil, types.WrapError(err, msg)
+	}
+	return gasPrices[0], nil
+}
+
+// defaultGasPricePercentiles are the percentiles returned by GetGasPricePercentiles if not specified.
+var defaultGasPricePercentiles = []int{25, 50, 75}
+
+// GetGasPricePercentiles samples the gas prices of transactions packed in the pivot blocks of recent epochs up to
+// the latest state epoch, and returns the specified percentiles of sampled gas prices in the same order, which are
+// 25th, 50th and 75th if not specified. It shows the distribution of gas prices to choose a competitive one.
+//
+// The percentiles are computed locally because conflux node does not provide fee history. The gas price responded
+// by cfx_gasPrice is returned for every percentile if no transaction sampled.
+func (client *Client) GetGasPricePercentiles(epochs uint64, percentiles ...int) ([]*big.Int, error) {
+	if len(percentiles) == 0 {
+		percentiles = defaultGasPricePercentiles
+	}
+	for _, p := range percentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("percentile %v is out of range [0, 100]", p)
+		}
+	}
+
+	samples, err := client.sampleGasPrices(epochs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*big.Int, len(percentiles))
+
+	if len(samples) == 0 {
+		gasPrice, err := client.GetGasPrice()
+		if err != nil {
+			return nil, types.WrapError(err, "get gas price error")
+		}
+		for i := range result {
+			result[i] = new(big.Int).Set(gasPrice)
+		}
+		return result, nil
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+	for i, p := range percentiles {
+		result[i] = new(big.Int).Set(samples[(len(samples)-1)*p/100])
+	}
+	return result, nil
+}
+
+// sampleGasPrices returns the gas prices of transactions packed in the pivot blocks of recent epochs.
+func (client *Client) sampleGasPrices(epochs uint64) ([]*big.Int, error) {
+	latest, err := client.GetEpochNumber(types.EpochLatestState)
+	if err != nil {
+		msg := fmt.Sprintf("get epoch number of %v error", types.EpochLatestState)
+		return nil, types.WrapError(err, msg)
+	}
+
+	var samples []*big.Int
+	epoch := new(big.Int).Set(latest)
+	for i := uint64(0); i < epochs && epoch.Sign() >= 0; i++ {
+		block, err := client.GetBlockByEpoch(types.NewEpochNumber(epoch))
+		if err != nil {
+			msg := fmt.Sprintf("get block of epoch %v error", epoch)
+			return nil, types.WrapError(err, msg)
+		}
+
+		if block != nil {
+			for _, tx := range block.Transactions {
+				if tx.GasPrice != nil {
+					samples = append(samples, tx.GasPrice.ToInt())
+				}
+			}
+		}
+		epoch = new(big.Int).Sub(epoch, big.NewInt(1))
+	}
+	return samples, nil
+}
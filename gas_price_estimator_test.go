@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newGasPriceRequester returns a MockRequester whose cfx_gasPrice is 100 and the pivot block of epoch n
+// contains transactions with gas price n*10 and n*10+5, the latest epoch is 4.
+func newGasPriceRequester() *sdktest.MockRequester {
+	requester := sdktest.NewMockRequester()
+	requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return "0x64", nil
+	})
+	requester.OnAny("cfx_epochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		return "0x4", nil
+	})
+	requester.OnAny("cfx_getBlockByEpochNumber").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		epoch, _ := args[0].(*types.Epoch).ToInt()
+		base := epoch.Int64() * 10
+		return map[string]interface{}{
+			"transactions": []map[string]interface{}{
+				{"gasPrice": types.NewBigInt(base)},
+				{"gasPrice": types.NewBigInt(base + 5)},
+			},
+		}, nil
+	})
+	return requester
+}
+
+func TestGasPriceEstimator(t *testing.T) {
+
+	Convey("Subject: Estimate gas price by tiers", t, func() {
+		requester := newGasPriceRequester()
+		client, _ := NewClientWithRPCRequester(requester)
+
+		Convey("Given a multiplier estimator", func() {
+			estimator := NewMultiplierGasPriceEstimator(1, 1.5, 2)
+			estimator.Premium = big.NewInt(1)
+
+			Convey("Return gas price multiplied by tier and added premium", func() {
+				slow, err := estimator.EstimateGasPrice(client, GasPriceSlow)
+				So(err, ShouldBeNil)
+				So(slow.Int64(), ShouldEqual, 101)
+
+				standard, _ := estimator.EstimateGasPrice(client, GasPriceStandard)
+				So(standard.Int64(), ShouldEqual, 151)
+
+				fast, _ := estimator.EstimateGasPrice(client, GasPriceFast)
+				So(fast.Int64(), ShouldEqual, 201)
+			})
+		})
+
+		Convey("Given a sampling estimator of 3 epochs", func() {
+			estimator := NewSamplingGasPriceEstimator(3)
+
+			Convey("Return percentiles of gas prices in recent epochs", func() {
+				slow, err := estimator.EstimateGasPrice(client, GasPriceSlow)
+				So(err, ShouldBeNil)
+				So(slow.Int64(), ShouldEqual, 25)
+
+				standard, _ := estimator.EstimateGasPrice(client, GasPriceStandard)
+				So(standard.Int64(), ShouldEqual, 30)
+
+				fast, _ := estimator.EstimateGasPrice(client, GasPriceFast)
+				So(fast.Int64(), ShouldEqual, 35)
+			})
+		})
+
+		Convey("Given client is set with an estimator", func() {
+			client.SetGasPriceEstimator(NewMultiplierGasPriceEstimator(1, 1, 3), GasPriceFast)
+
+			Convey("When apply default for a transaction without gas price", func() {
+				tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+				tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+				tx.Nonce = types.NewBigInt(1)
+				tx.ChainID = types.NewBigInt(1)
+				tx.EpochHeight = types.NewBigInt(1)
+				tx.Gas = types.NewBigInt(21000)
+				tx.StorageLimit = types.NewBigInt(0)
+				err := client.ApplyUnsignedTransactionDefault(tx)
+
+				Convey("The gas price is estimated by the estimator", func() {
+					So(err, ShouldBeNil)
+					So(tx.GasPrice.ToInt().Int64(), ShouldEqual, 300)
+				})
+			})
+		})
+	})
+}
+
+func TestGetGasPricePercentiles(t *testing.T) {
+
+	Convey("Subject: Get percentiles of gas prices in recent epochs", t, func() {
+		client, _ := NewClientWithRPCRequester(newGasPriceRequester())
+
+		Convey("Return 25th, 50th and 75th percentiles by default", func() {
+			gasPrices, err := client.GetGasPricePercentiles(3)
+			So(err, ShouldBeNil)
+			So(len(gasPrices), ShouldEqual, 3)
+			So(gasPrices[0].Int64(), ShouldEqual, 25)
+			So(gasPrices[1].Int64(), ShouldEqual, 30)
+			So(gasPrices[2].Int64(), ShouldEqual, 35)
+		})
+
+		Convey("Return the specified percentiles in order", func() {
+			gasPrices, err := client.GetGasPricePercentiles(3, 100, 0)
+			So(err, ShouldBeNil)
+			So(gasPrices[0].Int64(), ShouldEqual, 45)
+			So(gasPrices[1].Int64(), ShouldEqual, 20)
+		})
+
+		Convey("Return cfx_gasPrice if no transaction sampled", func() {
+			gasPrices, err := client.GetGasPricePercentiles(0, 50)
+			So(err, ShouldBeNil)
+			So(gasPrices[0].Int64(), ShouldEqual, 100)
+		})
+
+		Convey("Return error for percentile out of range", func() {
+			_, err := client.GetGasPricePercentiles(3, 101)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
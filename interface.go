@@ -5,12 +5,14 @@
 package sdk
 
 import (
+	"context"
 	"math/big"
 	"net/http"
 	"time"
 
 	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
 	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	// rpc "github.com/ethereum/go-ethereum/rpc"
 )
@@ -23,21 +25,53 @@ type HTTPRequester interface {
 // Contractor is interface of contract operator
 type Contractor interface {
 	GetData(method string, args ...interface{}) ([]byte, error)
+	GetDataHex(method string, args ...interface{}) (string, error)
 	Call(option *types.ContractMethodCallOption, resultPtr interface{}, method string, args ...interface{}) error
+	CallAndUnpack(option *types.ContractMethodCallOption, method string, args ...interface{}) ([]interface{}, error)
+	CallerAt(from *types.Address, epoch *types.Epoch) *ContractCaller
+	Simulate(option *types.ContractMethodCallOption, method string, args ...interface{}) (*SimulationResult, error)
+	CallWithData(option *types.ContractMethodCallOption, data []byte) ([]byte, error)
 	SendTransaction(option *types.ContractMethodSendOption, method string, args ...interface{}) (*types.Hash, error)
+	SendTransactionWithData(option *types.ContractMethodSendOption, data []byte) (*types.Hash, error)
+	SendAndWaitEvent(option *types.ContractMethodSendOption, eventName string, out interface{}, method string, args ...interface{}) (*types.TransactionReceipt, error)
+	EstimateGasAndCollateral(option *types.ContractMethodCallOption, method string, args ...interface{}) (*types.Estimate, error)
+	ABI() abi.ABI
+	Methods() []string
+	Events() []string
+	DecodeInput(data []byte) (method string, args map[string]interface{}, err error)
 	DecodeEvent(out interface{}, event string, log types.LogEntry) error
+	DecodeEventByTopic(log types.LogEntry) (eventName string, decoded map[string]interface{}, err error)
+	CreateLogFilter(eventName string, fromEpoch, toEpoch *types.Epoch, indexedArgs ...interface{}) (types.LogFilter, error)
+	SubscribeEvent(ctx context.Context, channel interface{}, eventName string, indexedFilters ...interface{}) (*Subscription, error)
 }
 
 // ClientOperator is interface of operate actions on client
 type ClientOperator interface {
 	GetGasPrice() (*big.Int, error)
+	GetGasPricePercentiles(epochs uint64, percentiles ...int) ([]*big.Int, error)
 	GetNextNonce(address types.Address, epoch *types.Epoch) (*big.Int, error)
 	GetStatus() (*types.Status, error)
+	GetChainID() (*big.Int, error)
+	GetNetworkID() (*big.Int, error)
 	GetEpochNumber(epoch ...*types.Epoch) (*big.Int, error)
 	GetBalance(address types.Address, epoch ...*types.Epoch) (*big.Int, error)
+	GetSupplyInfo(epoch ...*types.Epoch) (*types.SupplyInfo, error)
+	GetAccountPendingInfo(address types.Address) (*types.AccountPendingInfo, error)
+	GetCollateralForStorage(address types.Address, epoch ...*types.Epoch) (*big.Int, error)
+	GetSponsorInfo(contract types.Address, epoch ...*types.Epoch) (*types.SponsorInfo, error)
+	CheckBalanceAgainstTransaction(account types.Address, contract types.Address, gasLimit *hexutil.Big,
+		gasPrice *hexutil.Big, storageLimit *hexutil.Big, epoch ...*types.Epoch) (*types.CheckBalanceAgainstTransactionResponse, error)
+	GetAdmin(contract types.Address, epoch ...*types.Epoch) (*types.Address, error)
+	GetStakingBalance(address types.Address, epoch ...*types.Epoch) (*big.Int, error)
+	GetDepositList(address types.Address, epoch ...*types.Epoch) ([]types.DepositInfo, error)
+	GetVoteList(address types.Address, epoch ...*types.Epoch) ([]types.VoteStakeInfo, error)
 	GetCode(address types.Address, epoch ...*types.Epoch) (string, error)
+	VerifyDeployedCode(address types.Address, expectedRuntimeCode []byte, option ...*types.CodeVerifyOption) (bool, error)
 	GetBlockSummaryByHash(blockHash types.Hash) (*types.BlockSummary, error)
 	GetBlockByHash(blockHash types.Hash) (*types.Block, error)
+	GetBlockHeaderByHash(blockHash types.Hash) (*types.BlockHeader, error)
+	GetBlockHeaderByEpoch(epoch *types.Epoch) (*types.BlockHeader, error)
+	GetBlockByHashWithPivotAssumption(blockHash types.Hash, pivotHash types.Hash, epoch hexutil.Uint64) (*types.Block, error)
 	GetBlockSummaryByEpoch(epoch *types.Epoch) (*types.BlockSummary, error)
 	GetBlockByEpoch(epoch *types.Epoch) (*types.Block, error)
 	GetBestBlockHash() (types.Hash, error)
@@ -45,26 +79,69 @@ type ClientOperator interface {
 	GetBlockConfirmationRisk(blockHash types.Hash) (*big.Float, error)
 	SendRawTransaction(rawData []byte) (types.Hash, error)
 	SendTransaction(tx *types.UnsignedTransaction) (types.Hash, error)
+	SendTransactionWithPassphrase(tx *types.UnsignedTransaction, passphrase string) (types.Hash, error)
+	SendTransactionWithBalanceCheck(tx *types.UnsignedTransaction) (types.Hash, error)
+	CheckSenderBalance(tx *types.UnsignedTransaction) error
+	ResendTransaction(originalTx *types.UnsignedTransaction, newGasPrice *big.Int) (types.Hash, error)
+	SignTransaction(tx *types.UnsignedTransaction) ([]byte, types.Hash, error)
+	SignTransactionDetailed(tx *types.UnsignedTransaction) (raw []byte, sig types.Signature, hash types.Hash, err error)
 	SetAccountManager(accountManager AccountManagerOperator)
+	SetSigner(signer Signer)
+	SetNonceManager(nonceManager *NonceManager)
+	SetGasPriceEstimator(estimator GasPriceEstimator, tier GasPriceTier)
 	SignEncodedTransactionAndSend(encodedTx []byte, v byte, r, s []byte) (*types.Transaction, error)
 	Call(request types.CallRequest, epoch *types.Epoch) (*string, error)
 	CallRPC(result interface{}, method string, args ...interface{}) error
+	CallRPCWithOption(result interface{}, option *CallOption, method string, args ...interface{}) error
+	SetRetryableErrorPredicate(isRetryable func(err error) bool)
+	SetClock(clock Clock)
+	SetEstimateMargin(gasMargin, storageMargin float64)
+	SetNotFoundAsError(enabled bool)
+	SetMaxCallDataSize(size int)
+	SetNetworkCheck(enabled bool)
+	EnableCaching(ttl time.Duration)
 	BatchCallRPC(b []rpc.BatchElem) error
+	NewBatchRequest() *BatchRequest
 	GetLogs(filter types.LogFilter) ([]types.Log, error)
+	GetLogsChunked(filter types.LogFilter, chunkSize uint64) ([]types.Log, error)
+	IterateLogs(filter types.LogFilter, callback func(log types.Log) error) error
+	SubscribeLogs(ctx context.Context, channel chan<- types.Log, filter types.LogFilter) (*rpc.ClientSubscription, error)
+	SubscribeLogsWithReconnect(ctx context.Context, channel chan<- types.Log, filter types.LogFilter) (*Subscription, error)
+	SubscribeNewHeads(ctx context.Context, channel chan<- types.BlockHeader) (*rpc.ClientSubscription, error)
 	GetTransactionByHash(txHash types.Hash) (*types.Transaction, error)
-	EstimateGasAndCollateral(request types.CallRequest) (*types.Estimate, error)
+	GetVerifiedTransactionByHash(txHash types.Hash) (*types.VerifiedTransaction, error)
+	GetPendingTransaction(txHash types.Hash) (*types.Transaction, types.TransactionState, error)
+	GetTransactionState(txHash types.Hash) (types.TransactionState, error)
+	EstimateGasAndCollateral(request types.CallRequest, epoch ...*types.Epoch) (*types.Estimate, error)
+	BatchEstimateGasAndCollateral(requests []types.CallRequest, epoch ...*types.Epoch) ([]*types.Estimate, error)
 	GetBlocksByEpoch(epoch *types.Epoch) ([]types.Hash, error)
+	GetBlockSummariesByEpoch(epoch *types.Epoch) ([]types.BlockSummary, error)
+	GetBlockRewardInfo(epoch *types.Epoch) ([]types.RewardInfo, error)
 	GetTransactionReceipt(txHash types.Hash) (*types.TransactionReceipt, error)
+	GetEpochReceipts(epoch *types.Epoch) ([][]types.TransactionReceipt, error)
 	CreateUnsignedTransaction(from types.Address, to types.Address, amount *hexutil.Big, data []byte) (*types.UnsignedTransaction, error)
 	ApplyUnsignedTransactionDefault(tx *types.UnsignedTransaction) error
+	CallAndUnmarshal(resultPtr interface{}, method string, args ...interface{}) error
 	Debug(method string, args ...interface{}) (interface{}, error)
-	Close()
+	Close() error
+	Shutdown(ctx context.Context) error
+	WaitForTransactionMined(ctx context.Context, txHash types.Hash) (*types.MinedTransaction, error)
+	WaitForReceipt(ctx context.Context, txHash types.Hash) (*types.TransactionReceipt, error)
+	WaitForConfirmedReceipt(ctx context.Context, txHash types.Hash, maxRevertRate float64) (*types.TransactionReceipt, error)
+	WaitForEpochConfirmations(ctx context.Context, txHash types.Hash, confirmations uint64) (uint64, error)
+	SendTransactionAndWait(tx *types.UnsignedTransaction, option ...*types.TransactionWaitOption) (*types.TransactionReceipt, error)
 	GetContract(abiJSON []byte, deployedAt *types.Address) (*Contract, error)
 	// DeployContract(abiJSON string, bytecode []byte, option *types.ContractDeployOption, timeout time.Duration, callback func(deployedContract Contractor, hash *types.Hash, err error)) <-chan struct{}
 	DeployContract(option *types.ContractDeployOption, abiJSON []byte,
 		bytecode []byte, constroctorParams ...interface{}) *ContractDeployResult
+	DeployContractWithContext(ctx context.Context, option *types.ContractDeployOption, abiJSON []byte,
+		bytecode []byte, constroctorParams ...interface{}) *ContractDeployResult
+	DeployContractSync(option *types.ContractDeployOption, abiJSON []byte,
+		bytecode []byte, constroctorParams ...interface{}) (*Contract, types.Hash, error)
 
+	BatchGetCode(addresses []types.Address, epoch *types.Epoch) ([]string, error)
 	BatchGetTxByHashes(txhashes []types.Hash) (map[types.Hash]*types.Transaction, error)
+	BatchGetTransactionReceipts(txHashes []types.Hash) ([]*types.TransactionReceipt, error)
 	BatchGetBlockConfirmationRisk(blockhashes []types.Hash) (map[types.Hash]*big.Float, error)
 	BatchGetRawBlockConfirmationRisk(blockhashes []types.Hash) (map[types.Hash]*big.Int, error)
 	BatchGetBlockSummarys(blockhashes []types.Hash) (map[types.Hash]*types.BlockSummary, error)
@@ -75,6 +152,8 @@ type ClientOperator interface {
 type AccountManagerOperator interface {
 	Create(passphrase string) (types.Address, error)
 	Import(keyFile, passphrase, newPassphrase string) (types.Address, error)
+	ImportFromPrivateKey(hexKey, passphrase string) (types.Address, error)
+	ExportPrivateKey(address types.Address, passphrase string) (string, error)
 	Delete(address types.Address, passphrase string) error
 	Update(address types.Address, passphrase, newPassphrase string) error
 	List() []types.Address
@@ -88,10 +167,36 @@ type AccountManagerOperator interface {
 	SignAndEcodeTransactionWithPassphrase(tx types.UnsignedTransaction, passphrase string) ([]byte, error)
 	SignTransactionWithPassphrase(tx types.UnsignedTransaction, passphrase string) (*types.SignedTransaction, error)
 	Sign(tx types.UnsignedTransaction, passphrase string) (v byte, r, s []byte, err error)
+	SignMessage(address types.Address, message []byte) ([]byte, error)
+}
+
+// Signer is interface of signing transactions for an account, implement it to sign by the keys
+// kept outside the bundled keystore, such as AWS KMS, HashiCorp Vault or a hardware wallet.
+type Signer interface {
+	// Address returns the address of the account which signs transactions
+	Address() types.Address
+	// SignTransaction signs tx and returns its RLP encoded data with signature
+	SignTransaction(tx types.UnsignedTransaction) ([]byte, error)
 }
 
-type rpcRequester interface {
+// RPCRequester sends JSON-RPC requests to conflux node, it is implemented by *rpc.Client and could be implemented
+// by users to bring their own transport, see NewClientWithRPCRequester.
+//
+// Implementations must be safe for concurrent use. Subscriptions are supported if the requester also implements
+// Subscribe(ctx, namespace, channel, args...) like *rpc.Client.
+type RPCRequester interface {
+	// Call sends a request of method with args and unmarshals the result into resultPtr, which is a pointer
+	// or nil to ignore the result. The JSON-RPC error responded by node should be a *types.RPCError or implement
+	// rpc.Error and optionally rpc.DataError, so that it is not retried and its code and data are preserved.
 	Call(resultPtr interface{}, method string, args ...interface{}) error
+	// BatchCall sends all elements in a single batch request, the error of each element is set to its Error field,
+	// and the returned error is only for the failure of the whole batch, such as network error.
 	BatchCall(b []rpc.BatchElem) error
-	Close()
+	// Close closes the connection and returns the error of closing it, the requests sent after Close should fail.
+	Close() error
+}
+
+// rpcSubscriber is implemented by rpc requesters which support subscription, such as rpc.Client.
+type rpcSubscriber interface {
+	Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error)
 }
@@ -5,8 +5,10 @@
 package sdk
 
 import (
+	context "context"
 	rpc "github.com/Conflux-Chain/go-conflux-sdk/rpc"
 	types "github.com/Conflux-Chain/go-conflux-sdk/types"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
 	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	gomock "github.com/golang/mock/gomock"
 	big "math/big"
@@ -96,6 +98,26 @@ func (mr *MockContractorMockRecorder) GetData(method interface{}, args ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetData", reflect.TypeOf((*MockContractor)(nil).GetData), varargs...)
 }
 
+// GetDataHex mocks base method
+func (m *MockContractor) GetDataHex(method string, args ...interface{}) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDataHex", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataHex indicates an expected call of GetDataHex
+func (mr *MockContractorMockRecorder) GetDataHex(method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataHex", reflect.TypeOf((*MockContractor)(nil).GetDataHex), varargs...)
+}
+
 // Call mocks base method
 func (m *MockContractor) Call(option *types.ContractMethodCallOption, resultPtr interface{}, method string, args ...interface{}) error {
 	m.ctrl.T.Helper()
@@ -115,6 +137,75 @@ func (mr *MockContractorMockRecorder) Call(option, resultPtr, method interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockContractor)(nil).Call), varargs...)
 }
 
+// CallAndUnpack mocks base method
+func (m *MockContractor) CallAndUnpack(option *types.ContractMethodCallOption, method string, args ...interface{}) ([]interface{}, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{option, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CallAndUnpack", varargs...)
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallAndUnpack indicates an expected call of CallAndUnpack
+func (mr *MockContractorMockRecorder) CallAndUnpack(option, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{option, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallAndUnpack", reflect.TypeOf((*MockContractor)(nil).CallAndUnpack), varargs...)
+}
+
+// CallerAt mocks base method
+func (m *MockContractor) CallerAt(from *types.Address, epoch *types.Epoch) *ContractCaller {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallerAt", from, epoch)
+	ret0, _ := ret[0].(*ContractCaller)
+	return ret0
+}
+
+// CallerAt indicates an expected call of CallerAt
+func (mr *MockContractorMockRecorder) CallerAt(from, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallerAt", reflect.TypeOf((*MockContractor)(nil).CallerAt), from, epoch)
+}
+
+// Simulate mocks base method
+func (m *MockContractor) Simulate(option *types.ContractMethodCallOption, method string, args ...interface{}) (*SimulationResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{option, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Simulate", varargs...)
+	ret0, _ := ret[0].(*SimulationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate
+func (mr *MockContractorMockRecorder) Simulate(option, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{option, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockContractor)(nil).Simulate), varargs...)
+}
+
+// CallWithData mocks base method
+func (m *MockContractor) CallWithData(option *types.ContractMethodCallOption, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallWithData", option, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallWithData indicates an expected call of CallWithData
+func (mr *MockContractorMockRecorder) CallWithData(option, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallWithData", reflect.TypeOf((*MockContractor)(nil).CallWithData), option, data)
+}
+
 // SendTransaction mocks base method
 func (m *MockContractor) SendTransaction(option *types.ContractMethodSendOption, method string, args ...interface{}) (*types.Hash, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +226,119 @@ func (mr *MockContractorMockRecorder) SendTransaction(option, method interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockContractor)(nil).SendTransaction), varargs...)
 }
 
+// SendTransactionWithData mocks base method
+func (m *MockContractor) SendTransactionWithData(option *types.ContractMethodSendOption, data []byte) (*types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionWithData", option, data)
+	ret0, _ := ret[0].(*types.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionWithData indicates an expected call of SendTransactionWithData
+func (mr *MockContractorMockRecorder) SendTransactionWithData(option, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionWithData", reflect.TypeOf((*MockContractor)(nil).SendTransactionWithData), option, data)
+}
+
+// SendAndWaitEvent mocks base method
+func (m *MockContractor) SendAndWaitEvent(option *types.ContractMethodSendOption, eventName string, out interface{}, method string, args ...interface{}) (*types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{option, eventName, out, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendAndWaitEvent", varargs...)
+	ret0, _ := ret[0].(*types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendAndWaitEvent indicates an expected call of SendAndWaitEvent
+func (mr *MockContractorMockRecorder) SendAndWaitEvent(option, eventName, out, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{option, eventName, out, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndWaitEvent", reflect.TypeOf((*MockContractor)(nil).SendAndWaitEvent), varargs...)
+}
+
+// EstimateGasAndCollateral mocks base method
+func (m *MockContractor) EstimateGasAndCollateral(option *types.ContractMethodCallOption, method string, args ...interface{}) (*types.Estimate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{option, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EstimateGasAndCollateral", varargs...)
+	ret0, _ := ret[0].(*types.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGasAndCollateral indicates an expected call of EstimateGasAndCollateral
+func (mr *MockContractorMockRecorder) EstimateGasAndCollateral(option, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{option, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasAndCollateral", reflect.TypeOf((*MockContractor)(nil).EstimateGasAndCollateral), varargs...)
+}
+
+// ABI mocks base method
+func (m *MockContractor) ABI() abi.ABI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ABI")
+	ret0, _ := ret[0].(abi.ABI)
+	return ret0
+}
+
+// ABI indicates an expected call of ABI
+func (mr *MockContractorMockRecorder) ABI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ABI", reflect.TypeOf((*MockContractor)(nil).ABI))
+}
+
+// Methods mocks base method
+func (m *MockContractor) Methods() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Methods")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Methods indicates an expected call of Methods
+func (mr *MockContractorMockRecorder) Methods() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Methods", reflect.TypeOf((*MockContractor)(nil).Methods))
+}
+
+// Events mocks base method
+func (m *MockContractor) Events() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Events indicates an expected call of Events
+func (mr *MockContractorMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockContractor)(nil).Events))
+}
+
+// DecodeInput mocks base method
+func (m *MockContractor) DecodeInput(data []byte) (string, map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeInput", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(map[string]interface{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DecodeInput indicates an expected call of DecodeInput
+func (mr *MockContractorMockRecorder) DecodeInput(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeInput", reflect.TypeOf((*MockContractor)(nil).DecodeInput), data)
+}
+
 // DecodeEvent mocks base method
 func (m *MockContractor) DecodeEvent(out interface{}, event string, log types.LogEntry) error {
 	m.ctrl.T.Helper()
@@ -149,6 +353,62 @@ func (mr *MockContractorMockRecorder) DecodeEvent(out, event, log interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeEvent", reflect.TypeOf((*MockContractor)(nil).DecodeEvent), out, event, log)
 }
 
+// DecodeEventByTopic mocks base method
+func (m *MockContractor) DecodeEventByTopic(log types.LogEntry) (string, map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeEventByTopic", log)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(map[string]interface{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DecodeEventByTopic indicates an expected call of DecodeEventByTopic
+func (mr *MockContractorMockRecorder) DecodeEventByTopic(log interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeEventByTopic", reflect.TypeOf((*MockContractor)(nil).DecodeEventByTopic), log)
+}
+
+// CreateLogFilter mocks base method
+func (m *MockContractor) CreateLogFilter(eventName string, fromEpoch, toEpoch *types.Epoch, indexedArgs ...interface{}) (types.LogFilter, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{eventName, fromEpoch, toEpoch}
+	for _, a := range indexedArgs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLogFilter", varargs...)
+	ret0, _ := ret[0].(types.LogFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogFilter indicates an expected call of CreateLogFilter
+func (mr *MockContractorMockRecorder) CreateLogFilter(eventName, fromEpoch, toEpoch interface{}, indexedArgs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{eventName, fromEpoch, toEpoch}, indexedArgs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogFilter", reflect.TypeOf((*MockContractor)(nil).CreateLogFilter), varargs...)
+}
+
+// SubscribeEvent mocks base method
+func (m *MockContractor) SubscribeEvent(ctx context.Context, channel interface{}, eventName string, indexedFilters ...interface{}) (*Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, channel, eventName}
+	for _, a := range indexedFilters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeEvent", varargs...)
+	ret0, _ := ret[0].(*Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeEvent indicates an expected call of SubscribeEvent
+func (mr *MockContractorMockRecorder) SubscribeEvent(ctx, channel, eventName interface{}, indexedFilters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, channel, eventName}, indexedFilters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvent", reflect.TypeOf((*MockContractor)(nil).SubscribeEvent), varargs...)
+}
+
 // MockClientOperator is a mock of ClientOperator interface
 type MockClientOperator struct {
 	ctrl     *gomock.Controller
@@ -187,6 +447,86 @@ func (mr *MockClientOperatorMockRecorder) GetGasPrice() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasPrice", reflect.TypeOf((*MockClientOperator)(nil).GetGasPrice))
 }
 
+// GetGasPricePercentiles mocks base method
+func (m *MockClientOperator) GetGasPricePercentiles(epochs uint64, percentiles ...int) ([]*big.Int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{epochs}
+	for _, a := range percentiles {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGasPricePercentiles", varargs...)
+	ret0, _ := ret[0].([]*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGasPricePercentiles indicates an expected call of GetGasPricePercentiles
+func (mr *MockClientOperatorMockRecorder) GetGasPricePercentiles(epochs interface{}, percentiles ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{epochs}, percentiles...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasPricePercentiles", reflect.TypeOf((*MockClientOperator)(nil).GetGasPricePercentiles), varargs...)
+}
+
+// GetNextNonce mocks base method
+func (m *MockClientOperator) GetNextNonce(address types.Address, epoch *types.Epoch) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextNonce", address, epoch)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextNonce indicates an expected call of GetNextNonce
+func (mr *MockClientOperatorMockRecorder) GetNextNonce(address, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNonce", reflect.TypeOf((*MockClientOperator)(nil).GetNextNonce), address, epoch)
+}
+
+// GetStatus mocks base method
+func (m *MockClientOperator) GetStatus() (*types.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus")
+	ret0, _ := ret[0].(*types.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockClientOperatorMockRecorder) GetStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockClientOperator)(nil).GetStatus))
+}
+
+// GetChainID mocks base method
+func (m *MockClientOperator) GetChainID() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainID")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainID indicates an expected call of GetChainID
+func (mr *MockClientOperatorMockRecorder) GetChainID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainID", reflect.TypeOf((*MockClientOperator)(nil).GetChainID))
+}
+
+// GetNetworkID mocks base method
+func (m *MockClientOperator) GetNetworkID() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkID")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkID indicates an expected call of GetNetworkID
+func (mr *MockClientOperatorMockRecorder) GetNetworkID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkID", reflect.TypeOf((*MockClientOperator)(nil).GetNetworkID))
+}
+
 // GetEpochNumber mocks base method
 func (m *MockClientOperator) GetEpochNumber(epoch ...*types.Epoch) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +566,180 @@ func (mr *MockClientOperatorMockRecorder) GetBalance(address interface{}, epoch
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockClientOperator)(nil).GetBalance), varargs...)
 }
 
+// GetSupplyInfo mocks base method
+func (m *MockClientOperator) GetSupplyInfo(epoch ...*types.Epoch) (*types.SupplyInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSupplyInfo", varargs...)
+	ret0, _ := ret[0].(*types.SupplyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyInfo indicates an expected call of GetSupplyInfo
+func (mr *MockClientOperatorMockRecorder) GetSupplyInfo(epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyInfo", reflect.TypeOf((*MockClientOperator)(nil).GetSupplyInfo), epoch...)
+}
+
+// GetAccountPendingInfo mocks base method
+func (m *MockClientOperator) GetAccountPendingInfo(address types.Address) (*types.AccountPendingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountPendingInfo", address)
+	ret0, _ := ret[0].(*types.AccountPendingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountPendingInfo indicates an expected call of GetAccountPendingInfo
+func (mr *MockClientOperatorMockRecorder) GetAccountPendingInfo(address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountPendingInfo", reflect.TypeOf((*MockClientOperator)(nil).GetAccountPendingInfo), address)
+}
+
+// GetCollateralForStorage mocks base method
+func (m *MockClientOperator) GetCollateralForStorage(address types.Address, epoch ...*types.Epoch) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{address}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCollateralForStorage", varargs...)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollateralForStorage indicates an expected call of GetCollateralForStorage
+func (mr *MockClientOperatorMockRecorder) GetCollateralForStorage(address interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{address}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateralForStorage", reflect.TypeOf((*MockClientOperator)(nil).GetCollateralForStorage), varargs...)
+}
+
+// GetSponsorInfo mocks base method
+func (m *MockClientOperator) GetSponsorInfo(contract types.Address, epoch ...*types.Epoch) (*types.SponsorInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{contract}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSponsorInfo", varargs...)
+	ret0, _ := ret[0].(*types.SponsorInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSponsorInfo indicates an expected call of GetSponsorInfo
+func (mr *MockClientOperatorMockRecorder) GetSponsorInfo(contract interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{contract}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSponsorInfo", reflect.TypeOf((*MockClientOperator)(nil).GetSponsorInfo), varargs...)
+}
+
+// CheckBalanceAgainstTransaction mocks base method
+func (m *MockClientOperator) CheckBalanceAgainstTransaction(account, contract types.Address, gasLimit, gasPrice, storageLimit *hexutil.Big, epoch ...*types.Epoch) (*types.CheckBalanceAgainstTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{account, contract, gasLimit, gasPrice, storageLimit}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckBalanceAgainstTransaction", varargs...)
+	ret0, _ := ret[0].(*types.CheckBalanceAgainstTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBalanceAgainstTransaction indicates an expected call of CheckBalanceAgainstTransaction
+func (mr *MockClientOperatorMockRecorder) CheckBalanceAgainstTransaction(account, contract, gasLimit, gasPrice, storageLimit interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{account, contract, gasLimit, gasPrice, storageLimit}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalanceAgainstTransaction", reflect.TypeOf((*MockClientOperator)(nil).CheckBalanceAgainstTransaction), varargs...)
+}
+
+// GetAdmin mocks base method
+func (m *MockClientOperator) GetAdmin(contract types.Address, epoch ...*types.Epoch) (*types.Address, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{contract}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAdmin", varargs...)
+	ret0, _ := ret[0].(*types.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdmin indicates an expected call of GetAdmin
+func (mr *MockClientOperatorMockRecorder) GetAdmin(contract interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{contract}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmin", reflect.TypeOf((*MockClientOperator)(nil).GetAdmin), varargs...)
+}
+
+// GetStakingBalance mocks base method
+func (m *MockClientOperator) GetStakingBalance(address types.Address, epoch ...*types.Epoch) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{address}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStakingBalance", varargs...)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakingBalance indicates an expected call of GetStakingBalance
+func (mr *MockClientOperatorMockRecorder) GetStakingBalance(address interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{address}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingBalance", reflect.TypeOf((*MockClientOperator)(nil).GetStakingBalance), varargs...)
+}
+
+// GetDepositList mocks base method
+func (m *MockClientOperator) GetDepositList(address types.Address, epoch ...*types.Epoch) ([]types.DepositInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{address}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDepositList", varargs...)
+	ret0, _ := ret[0].([]types.DepositInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositList indicates an expected call of GetDepositList
+func (mr *MockClientOperatorMockRecorder) GetDepositList(address interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{address}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositList", reflect.TypeOf((*MockClientOperator)(nil).GetDepositList), varargs...)
+}
+
+// GetVoteList mocks base method
+func (m *MockClientOperator) GetVoteList(address types.Address, epoch ...*types.Epoch) ([]types.VoteStakeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{address}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVoteList", varargs...)
+	ret0, _ := ret[0].([]types.VoteStakeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoteList indicates an expected call of GetVoteList
+func (mr *MockClientOperatorMockRecorder) GetVoteList(address interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{address}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoteList", reflect.TypeOf((*MockClientOperator)(nil).GetVoteList), varargs...)
+}
+
 // GetCode mocks base method
 func (m *MockClientOperator) GetCode(address types.Address, epoch ...*types.Epoch) (string, error) {
 	m.ctrl.T.Helper()
@@ -239,11 +753,31 @@ func (m *MockClientOperator) GetCode(address types.Address, epoch ...*types.Epoc
 	return ret0, ret1
 }
 
-// GetCode indicates an expected call of GetCode
-func (mr *MockClientOperatorMockRecorder) GetCode(address interface{}, epoch ...interface{}) *gomock.Call {
+// GetCode indicates an expected call of GetCode
+func (mr *MockClientOperatorMockRecorder) GetCode(address interface{}, epoch ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{address}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCode", reflect.TypeOf((*MockClientOperator)(nil).GetCode), varargs...)
+}
+
+// VerifyDeployedCode mocks base method
+func (m *MockClientOperator) VerifyDeployedCode(address types.Address, expectedRuntimeCode []byte, option ...*types.CodeVerifyOption) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{address, expectedRuntimeCode}
+	for _, a := range option {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyDeployedCode", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyDeployedCode indicates an expected call of VerifyDeployedCode
+func (mr *MockClientOperatorMockRecorder) VerifyDeployedCode(address, expectedRuntimeCode interface{}, option ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{address}, epoch...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCode", reflect.TypeOf((*MockClientOperator)(nil).GetCode), varargs...)
+	varargs := append([]interface{}{address, expectedRuntimeCode}, option...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyDeployedCode", reflect.TypeOf((*MockClientOperator)(nil).VerifyDeployedCode), varargs...)
 }
 
 // GetBlockSummaryByHash mocks base method
@@ -276,6 +810,51 @@ func (mr *MockClientOperatorMockRecorder) GetBlockByHash(blockHash interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByHash", reflect.TypeOf((*MockClientOperator)(nil).GetBlockByHash), blockHash)
 }
 
+// GetBlockHeaderByHash mocks base method
+func (m *MockClientOperator) GetBlockHeaderByHash(blockHash types.Hash) (*types.BlockHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHeaderByHash", blockHash)
+	ret0, _ := ret[0].(*types.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeaderByHash indicates an expected call of GetBlockHeaderByHash
+func (mr *MockClientOperatorMockRecorder) GetBlockHeaderByHash(blockHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeaderByHash", reflect.TypeOf((*MockClientOperator)(nil).GetBlockHeaderByHash), blockHash)
+}
+
+// GetBlockHeaderByEpoch mocks base method
+func (m *MockClientOperator) GetBlockHeaderByEpoch(epoch *types.Epoch) (*types.BlockHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHeaderByEpoch", epoch)
+	ret0, _ := ret[0].(*types.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeaderByEpoch indicates an expected call of GetBlockHeaderByEpoch
+func (mr *MockClientOperatorMockRecorder) GetBlockHeaderByEpoch(epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeaderByEpoch", reflect.TypeOf((*MockClientOperator)(nil).GetBlockHeaderByEpoch), epoch)
+}
+
+// GetBlockByHashWithPivotAssumption mocks base method
+func (m *MockClientOperator) GetBlockByHashWithPivotAssumption(blockHash, pivotHash types.Hash, epoch hexutil.Uint64) (*types.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockByHashWithPivotAssumption", blockHash, pivotHash, epoch)
+	ret0, _ := ret[0].(*types.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByHashWithPivotAssumption indicates an expected call of GetBlockByHashWithPivotAssumption
+func (mr *MockClientOperatorMockRecorder) GetBlockByHashWithPivotAssumption(blockHash, pivotHash, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByHashWithPivotAssumption", reflect.TypeOf((*MockClientOperator)(nil).GetBlockByHashWithPivotAssumption), blockHash, pivotHash, epoch)
+}
+
 // GetBlockSummaryByEpoch mocks base method
 func (m *MockClientOperator) GetBlockSummaryByEpoch(epoch *types.Epoch) (*types.BlockSummary, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +960,98 @@ func (mr *MockClientOperatorMockRecorder) SendTransaction(tx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockClientOperator)(nil).SendTransaction), tx)
 }
 
+// SendTransactionWithPassphrase mocks base method
+func (m *MockClientOperator) SendTransactionWithPassphrase(tx *types.UnsignedTransaction, passphrase string) (types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionWithPassphrase", tx, passphrase)
+	ret0, _ := ret[0].(types.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionWithPassphrase indicates an expected call of SendTransactionWithPassphrase
+func (mr *MockClientOperatorMockRecorder) SendTransactionWithPassphrase(tx, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionWithPassphrase", reflect.TypeOf((*MockClientOperator)(nil).SendTransactionWithPassphrase), tx, passphrase)
+}
+
+// SendTransactionWithBalanceCheck mocks base method
+func (m *MockClientOperator) SendTransactionWithBalanceCheck(tx *types.UnsignedTransaction) (types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionWithBalanceCheck", tx)
+	ret0, _ := ret[0].(types.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionWithBalanceCheck indicates an expected call of SendTransactionWithBalanceCheck
+func (mr *MockClientOperatorMockRecorder) SendTransactionWithBalanceCheck(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionWithBalanceCheck", reflect.TypeOf((*MockClientOperator)(nil).SendTransactionWithBalanceCheck), tx)
+}
+
+// CheckSenderBalance mocks base method
+func (m *MockClientOperator) CheckSenderBalance(tx *types.UnsignedTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSenderBalance", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSenderBalance indicates an expected call of CheckSenderBalance
+func (mr *MockClientOperatorMockRecorder) CheckSenderBalance(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSenderBalance", reflect.TypeOf((*MockClientOperator)(nil).CheckSenderBalance), tx)
+}
+
+// ResendTransaction mocks base method
+func (m *MockClientOperator) ResendTransaction(originalTx *types.UnsignedTransaction, newGasPrice *big.Int) (types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendTransaction", originalTx, newGasPrice)
+	ret0, _ := ret[0].(types.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendTransaction indicates an expected call of ResendTransaction
+func (mr *MockClientOperatorMockRecorder) ResendTransaction(originalTx, newGasPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendTransaction", reflect.TypeOf((*MockClientOperator)(nil).ResendTransaction), originalTx, newGasPrice)
+}
+
+// SignTransaction mocks base method
+func (m *MockClientOperator) SignTransaction(tx *types.UnsignedTransaction) ([]byte, types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(types.Hash)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignTransaction indicates an expected call of SignTransaction
+func (mr *MockClientOperatorMockRecorder) SignTransaction(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockClientOperator)(nil).SignTransaction), tx)
+}
+
+// SignTransactionDetailed mocks base method
+func (m *MockClientOperator) SignTransactionDetailed(tx *types.UnsignedTransaction) ([]byte, types.Signature, types.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransactionDetailed", tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(types.Signature)
+	ret2, _ := ret[2].(types.Hash)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SignTransactionDetailed indicates an expected call of SignTransactionDetailed
+func (mr *MockClientOperatorMockRecorder) SignTransactionDetailed(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransactionDetailed", reflect.TypeOf((*MockClientOperator)(nil).SignTransactionDetailed), tx)
+}
+
 // SetAccountManager mocks base method
 func (m *MockClientOperator) SetAccountManager(accountManager AccountManagerOperator) {
 	m.ctrl.T.Helper()
@@ -393,6 +1064,42 @@ func (mr *MockClientOperatorMockRecorder) SetAccountManager(accountManager inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountManager", reflect.TypeOf((*MockClientOperator)(nil).SetAccountManager), accountManager)
 }
 
+// SetSigner mocks base method
+func (m *MockClientOperator) SetSigner(signer Signer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSigner", signer)
+}
+
+// SetSigner indicates an expected call of SetSigner
+func (mr *MockClientOperatorMockRecorder) SetSigner(signer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSigner", reflect.TypeOf((*MockClientOperator)(nil).SetSigner), signer)
+}
+
+// SetNonceManager mocks base method
+func (m *MockClientOperator) SetNonceManager(nonceManager *NonceManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNonceManager", nonceManager)
+}
+
+// SetNonceManager indicates an expected call of SetNonceManager
+func (mr *MockClientOperatorMockRecorder) SetNonceManager(nonceManager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNonceManager", reflect.TypeOf((*MockClientOperator)(nil).SetNonceManager), nonceManager)
+}
+
+// SetGasPriceEstimator mocks base method
+func (m *MockClientOperator) SetGasPriceEstimator(estimator GasPriceEstimator, tier GasPriceTier) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGasPriceEstimator", estimator, tier)
+}
+
+// SetGasPriceEstimator indicates an expected call of SetGasPriceEstimator
+func (mr *MockClientOperatorMockRecorder) SetGasPriceEstimator(estimator, tier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGasPriceEstimator", reflect.TypeOf((*MockClientOperator)(nil).SetGasPriceEstimator), estimator, tier)
+}
+
 // SignEncodedTransactionAndSend mocks base method
 func (m *MockClientOperator) SignEncodedTransactionAndSend(encodedTx []byte, v byte, r, s []byte) (*types.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -435,70 +1142,332 @@ func (m *MockClientOperator) CallRPC(result interface{}, method string, args ...
 	return ret0
 }
 
-// CallRPC indicates an expected call of CallRPC
-func (mr *MockClientOperatorMockRecorder) CallRPC(result, method interface{}, args ...interface{}) *gomock.Call {
+// CallRPC indicates an expected call of CallRPC
+func (mr *MockClientOperatorMockRecorder) CallRPC(result, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{result, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallRPC", reflect.TypeOf((*MockClientOperator)(nil).CallRPC), varargs...)
+}
+
+// CallRPCWithOption mocks base method
+func (m *MockClientOperator) CallRPCWithOption(result interface{}, option *CallOption, method string, args ...interface{}) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{result, option, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CallRPCWithOption", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallRPCWithOption indicates an expected call of CallRPCWithOption
+func (mr *MockClientOperatorMockRecorder) CallRPCWithOption(result, option, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{result, option, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallRPCWithOption", reflect.TypeOf((*MockClientOperator)(nil).CallRPCWithOption), varargs...)
+}
+
+// SetRetryableErrorPredicate mocks base method
+func (m *MockClientOperator) SetRetryableErrorPredicate(isRetryable func(error) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRetryableErrorPredicate", isRetryable)
+}
+
+// SetRetryableErrorPredicate indicates an expected call of SetRetryableErrorPredicate
+func (mr *MockClientOperatorMockRecorder) SetRetryableErrorPredicate(isRetryable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetryableErrorPredicate", reflect.TypeOf((*MockClientOperator)(nil).SetRetryableErrorPredicate), isRetryable)
+}
+
+// SetClock mocks base method
+func (m *MockClientOperator) SetClock(clock Clock) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetClock", clock)
+}
+
+// SetClock indicates an expected call of SetClock
+func (mr *MockClientOperatorMockRecorder) SetClock(clock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClock", reflect.TypeOf((*MockClientOperator)(nil).SetClock), clock)
+}
+
+// SetEstimateMargin mocks base method
+func (m *MockClientOperator) SetEstimateMargin(gasMargin, storageMargin float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEstimateMargin", gasMargin, storageMargin)
+}
+
+// SetEstimateMargin indicates an expected call of SetEstimateMargin
+func (mr *MockClientOperatorMockRecorder) SetEstimateMargin(gasMargin, storageMargin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEstimateMargin", reflect.TypeOf((*MockClientOperator)(nil).SetEstimateMargin), gasMargin, storageMargin)
+}
+
+// SetNotFoundAsError mocks base method
+func (m *MockClientOperator) SetNotFoundAsError(enabled bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNotFoundAsError", enabled)
+}
+
+// SetNotFoundAsError indicates an expected call of SetNotFoundAsError
+func (mr *MockClientOperatorMockRecorder) SetNotFoundAsError(enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotFoundAsError", reflect.TypeOf((*MockClientOperator)(nil).SetNotFoundAsError), enabled)
+}
+
+// SetMaxCallDataSize mocks base method
+func (m *MockClientOperator) SetMaxCallDataSize(size int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxCallDataSize", size)
+}
+
+// SetMaxCallDataSize indicates an expected call of SetMaxCallDataSize
+func (mr *MockClientOperatorMockRecorder) SetMaxCallDataSize(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxCallDataSize", reflect.TypeOf((*MockClientOperator)(nil).SetMaxCallDataSize), size)
+}
+
+// SetNetworkCheck mocks base method
+func (m *MockClientOperator) SetNetworkCheck(enabled bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNetworkCheck", enabled)
+}
+
+// SetNetworkCheck indicates an expected call of SetNetworkCheck
+func (mr *MockClientOperatorMockRecorder) SetNetworkCheck(enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetworkCheck", reflect.TypeOf((*MockClientOperator)(nil).SetNetworkCheck), enabled)
+}
+
+// EnableCaching mocks base method
+func (m *MockClientOperator) EnableCaching(ttl time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EnableCaching", ttl)
+}
+
+// EnableCaching indicates an expected call of EnableCaching
+func (mr *MockClientOperatorMockRecorder) EnableCaching(ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableCaching", reflect.TypeOf((*MockClientOperator)(nil).EnableCaching), ttl)
+}
+
+// BatchCallRPC mocks base method
+func (m *MockClientOperator) BatchCallRPC(b []rpc.BatchElem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCallRPC", b)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCallRPC indicates an expected call of BatchCallRPC
+func (mr *MockClientOperatorMockRecorder) BatchCallRPC(b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallRPC", reflect.TypeOf((*MockClientOperator)(nil).BatchCallRPC), b)
+}
+
+// NewBatchRequest mocks base method
+func (m *MockClientOperator) NewBatchRequest() *BatchRequest {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBatchRequest")
+	ret0, _ := ret[0].(*BatchRequest)
+	return ret0
+}
+
+// NewBatchRequest indicates an expected call of NewBatchRequest
+func (mr *MockClientOperatorMockRecorder) NewBatchRequest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBatchRequest", reflect.TypeOf((*MockClientOperator)(nil).NewBatchRequest))
+}
+
+// GetLogs mocks base method
+func (m *MockClientOperator) GetLogs(filter types.LogFilter) ([]types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogs", filter)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs
+func (mr *MockClientOperatorMockRecorder) GetLogs(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockClientOperator)(nil).GetLogs), filter)
+}
+
+// GetLogsChunked mocks base method
+func (m *MockClientOperator) GetLogsChunked(filter types.LogFilter, chunkSize uint64) ([]types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogsChunked", filter, chunkSize)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogsChunked indicates an expected call of GetLogsChunked
+func (mr *MockClientOperatorMockRecorder) GetLogsChunked(filter, chunkSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsChunked", reflect.TypeOf((*MockClientOperator)(nil).GetLogsChunked), filter, chunkSize)
+}
+
+// IterateLogs mocks base method
+func (m *MockClientOperator) IterateLogs(filter types.LogFilter, callback func(types.Log) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateLogs", filter, callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateLogs indicates an expected call of IterateLogs
+func (mr *MockClientOperatorMockRecorder) IterateLogs(filter, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateLogs", reflect.TypeOf((*MockClientOperator)(nil).IterateLogs), filter, callback)
+}
+
+// SubscribeLogs mocks base method
+func (m *MockClientOperator) SubscribeLogs(ctx context.Context, channel chan<- types.Log, filter types.LogFilter) (*rpc.ClientSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeLogs", ctx, channel, filter)
+	ret0, _ := ret[0].(*rpc.ClientSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeLogs indicates an expected call of SubscribeLogs
+func (mr *MockClientOperatorMockRecorder) SubscribeLogs(ctx, channel, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeLogs", reflect.TypeOf((*MockClientOperator)(nil).SubscribeLogs), ctx, channel, filter)
+}
+
+// SubscribeLogsWithReconnect mocks base method
+func (m *MockClientOperator) SubscribeLogsWithReconnect(ctx context.Context, channel chan<- types.Log, filter types.LogFilter) (*Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeLogsWithReconnect", ctx, channel, filter)
+	ret0, _ := ret[0].(*Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeLogsWithReconnect indicates an expected call of SubscribeLogsWithReconnect
+func (mr *MockClientOperatorMockRecorder) SubscribeLogsWithReconnect(ctx, channel, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeLogsWithReconnect", reflect.TypeOf((*MockClientOperator)(nil).SubscribeLogsWithReconnect), ctx, channel, filter)
+}
+
+// SubscribeNewHeads mocks base method
+func (m *MockClientOperator) SubscribeNewHeads(ctx context.Context, channel chan<- types.BlockHeader) (*rpc.ClientSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewHeads", ctx, channel)
+	ret0, _ := ret[0].(*rpc.ClientSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewHeads indicates an expected call of SubscribeNewHeads
+func (mr *MockClientOperatorMockRecorder) SubscribeNewHeads(ctx, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewHeads", reflect.TypeOf((*MockClientOperator)(nil).SubscribeNewHeads), ctx, channel)
+}
+
+// GetTransactionByHash mocks base method
+func (m *MockClientOperator) GetTransactionByHash(txHash types.Hash) (*types.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByHash", txHash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByHash indicates an expected call of GetTransactionByHash
+func (mr *MockClientOperatorMockRecorder) GetTransactionByHash(txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockClientOperator)(nil).GetTransactionByHash), txHash)
+}
+
+// GetVerifiedTransactionByHash mocks base method
+func (m *MockClientOperator) GetVerifiedTransactionByHash(txHash types.Hash) (*types.VerifiedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerifiedTransactionByHash", txHash)
+	ret0, _ := ret[0].(*types.VerifiedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerifiedTransactionByHash indicates an expected call of GetVerifiedTransactionByHash
+func (mr *MockClientOperatorMockRecorder) GetVerifiedTransactionByHash(txHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{result, method}, args...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallRPC", reflect.TypeOf((*MockClientOperator)(nil).CallRPC), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerifiedTransactionByHash", reflect.TypeOf((*MockClientOperator)(nil).GetVerifiedTransactionByHash), txHash)
 }
 
-// BatchCallRPC mocks base method
-func (m *MockClientOperator) BatchCallRPC(b []rpc.BatchElem) error {
+// GetPendingTransaction mocks base method
+func (m *MockClientOperator) GetPendingTransaction(txHash types.Hash) (*types.Transaction, types.TransactionState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BatchCallRPC", b)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetPendingTransaction", txHash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(types.TransactionState)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// BatchCallRPC indicates an expected call of BatchCallRPC
-func (mr *MockClientOperatorMockRecorder) BatchCallRPC(b interface{}) *gomock.Call {
+// GetPendingTransaction indicates an expected call of GetPendingTransaction
+func (mr *MockClientOperatorMockRecorder) GetPendingTransaction(txHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallRPC", reflect.TypeOf((*MockClientOperator)(nil).BatchCallRPC), b)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransaction", reflect.TypeOf((*MockClientOperator)(nil).GetPendingTransaction), txHash)
 }
 
-// GetLogs mocks base method
-func (m *MockClientOperator) GetLogs(filter types.LogFilter) ([]types.Log, error) {
+// GetTransactionState mocks base method
+func (m *MockClientOperator) GetTransactionState(txHash types.Hash) (types.TransactionState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogs", filter)
-	ret0, _ := ret[0].([]types.Log)
+	ret := m.ctrl.Call(m, "GetTransactionState", txHash)
+	ret0, _ := ret[0].(types.TransactionState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogs indicates an expected call of GetLogs
-func (mr *MockClientOperatorMockRecorder) GetLogs(filter interface{}) *gomock.Call {
+// GetTransactionState indicates an expected call of GetTransactionState
+func (mr *MockClientOperatorMockRecorder) GetTransactionState(txHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockClientOperator)(nil).GetLogs), filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionState", reflect.TypeOf((*MockClientOperator)(nil).GetTransactionState), txHash)
 }
 
-// GetTransactionByHash mocks base method
-func (m *MockClientOperator) GetTransactionByHash(txHash types.Hash) (*types.Transaction, error) {
+// EstimateGasAndCollateral mocks base method
+func (m *MockClientOperator) EstimateGasAndCollateral(request types.CallRequest, epoch ...*types.Epoch) (*types.Estimate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactionByHash", txHash)
-	ret0, _ := ret[0].(*types.Transaction)
+	varargs := []interface{}{request}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EstimateGasAndCollateral", varargs...)
+	ret0, _ := ret[0].(*types.Estimate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransactionByHash indicates an expected call of GetTransactionByHash
-func (mr *MockClientOperatorMockRecorder) GetTransactionByHash(txHash interface{}) *gomock.Call {
+// EstimateGasAndCollateral indicates an expected call of EstimateGasAndCollateral
+func (mr *MockClientOperatorMockRecorder) EstimateGasAndCollateral(request interface{}, epoch ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockClientOperator)(nil).GetTransactionByHash), txHash)
+	varargs := append([]interface{}{request}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasAndCollateral", reflect.TypeOf((*MockClientOperator)(nil).EstimateGasAndCollateral), varargs...)
 }
 
-// EstimateGasAndCollateral mocks base method
-func (m *MockClientOperator) EstimateGasAndCollateral(request types.CallRequest) (*types.Estimate, error) {
+// BatchEstimateGasAndCollateral mocks base method
+func (m *MockClientOperator) BatchEstimateGasAndCollateral(requests []types.CallRequest, epoch ...*types.Epoch) ([]*types.Estimate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EstimateGasAndCollateral", request)
-	ret0, _ := ret[0].(*types.Estimate)
+	varargs := []interface{}{requests}
+	for _, a := range epoch {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchEstimateGasAndCollateral", varargs...)
+	ret0, _ := ret[0].([]*types.Estimate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EstimateGasAndCollateral indicates an expected call of EstimateGasAndCollateral
-func (mr *MockClientOperatorMockRecorder) EstimateGasAndCollateral(request interface{}) *gomock.Call {
+// BatchEstimateGasAndCollateral indicates an expected call of BatchEstimateGasAndCollateral
+func (mr *MockClientOperatorMockRecorder) BatchEstimateGasAndCollateral(requests interface{}, epoch ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasAndCollateral", reflect.TypeOf((*MockClientOperator)(nil).EstimateGasAndCollateral), request)
+	varargs := append([]interface{}{requests}, epoch...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchEstimateGasAndCollateral", reflect.TypeOf((*MockClientOperator)(nil).BatchEstimateGasAndCollateral), varargs...)
 }
 
 // GetBlocksByEpoch mocks base method
@@ -516,6 +1485,36 @@ func (mr *MockClientOperatorMockRecorder) GetBlocksByEpoch(epoch interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByEpoch", reflect.TypeOf((*MockClientOperator)(nil).GetBlocksByEpoch), epoch)
 }
 
+// GetBlockSummariesByEpoch mocks base method
+func (m *MockClientOperator) GetBlockSummariesByEpoch(epoch *types.Epoch) ([]types.BlockSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockSummariesByEpoch", epoch)
+	ret0, _ := ret[0].([]types.BlockSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockSummariesByEpoch indicates an expected call of GetBlockSummariesByEpoch
+func (mr *MockClientOperatorMockRecorder) GetBlockSummariesByEpoch(epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockSummariesByEpoch", reflect.TypeOf((*MockClientOperator)(nil).GetBlockSummariesByEpoch), epoch)
+}
+
+// GetBlockRewardInfo mocks base method
+func (m *MockClientOperator) GetBlockRewardInfo(epoch *types.Epoch) ([]types.RewardInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockRewardInfo", epoch)
+	ret0, _ := ret[0].([]types.RewardInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockRewardInfo indicates an expected call of GetBlockRewardInfo
+func (mr *MockClientOperatorMockRecorder) GetBlockRewardInfo(epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockRewardInfo", reflect.TypeOf((*MockClientOperator)(nil).GetBlockRewardInfo), epoch)
+}
+
 // GetTransactionReceipt mocks base method
 func (m *MockClientOperator) GetTransactionReceipt(txHash types.Hash) (*types.TransactionReceipt, error) {
 	m.ctrl.T.Helper()
@@ -531,6 +1530,21 @@ func (mr *MockClientOperatorMockRecorder) GetTransactionReceipt(txHash interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipt", reflect.TypeOf((*MockClientOperator)(nil).GetTransactionReceipt), txHash)
 }
 
+// GetEpochReceipts mocks base method
+func (m *MockClientOperator) GetEpochReceipts(epoch *types.Epoch) ([][]types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochReceipts", epoch)
+	ret0, _ := ret[0].([][]types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochReceipts indicates an expected call of GetEpochReceipts
+func (mr *MockClientOperatorMockRecorder) GetEpochReceipts(epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochReceipts", reflect.TypeOf((*MockClientOperator)(nil).GetEpochReceipts), epoch)
+}
+
 // CreateUnsignedTransaction mocks base method
 func (m *MockClientOperator) CreateUnsignedTransaction(from, to types.Address, amount *hexutil.Big, data []byte) (*types.UnsignedTransaction, error) {
 	m.ctrl.T.Helper()
@@ -560,6 +1574,25 @@ func (mr *MockClientOperatorMockRecorder) ApplyUnsignedTransactionDefault(tx int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUnsignedTransactionDefault", reflect.TypeOf((*MockClientOperator)(nil).ApplyUnsignedTransactionDefault), tx)
 }
 
+// CallAndUnmarshal mocks base method
+func (m *MockClientOperator) CallAndUnmarshal(resultPtr interface{}, method string, args ...interface{}) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{resultPtr, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CallAndUnmarshal", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallAndUnmarshal indicates an expected call of CallAndUnmarshal
+func (mr *MockClientOperatorMockRecorder) CallAndUnmarshal(resultPtr, method interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{resultPtr, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallAndUnmarshal", reflect.TypeOf((*MockClientOperator)(nil).CallAndUnmarshal), varargs...)
+}
+
 // Debug mocks base method
 func (m *MockClientOperator) Debug(method string, args ...interface{}) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -581,9 +1614,11 @@ func (mr *MockClientOperatorMockRecorder) Debug(method interface{}, args ...inte
 }
 
 // Close mocks base method
-func (m *MockClientOperator) Close() {
+func (m *MockClientOperator) Close() error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Close")
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Close indicates an expected call of Close
@@ -592,6 +1627,100 @@ func (mr *MockClientOperatorMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClientOperator)(nil).Close))
 }
 
+// Shutdown mocks base method
+func (m *MockClientOperator) Shutdown(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Shutdown indicates an expected call of Shutdown
+func (mr *MockClientOperatorMockRecorder) Shutdown(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockClientOperator)(nil).Shutdown), ctx)
+}
+
+// WaitForTransactionMined mocks base method
+func (m *MockClientOperator) WaitForTransactionMined(ctx context.Context, txHash types.Hash) (*types.MinedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForTransactionMined", ctx, txHash)
+	ret0, _ := ret[0].(*types.MinedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForTransactionMined indicates an expected call of WaitForTransactionMined
+func (mr *MockClientOperatorMockRecorder) WaitForTransactionMined(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForTransactionMined", reflect.TypeOf((*MockClientOperator)(nil).WaitForTransactionMined), ctx, txHash)
+}
+
+// WaitForReceipt mocks base method
+func (m *MockClientOperator) WaitForReceipt(ctx context.Context, txHash types.Hash) (*types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForReceipt indicates an expected call of WaitForReceipt
+func (mr *MockClientOperatorMockRecorder) WaitForReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForReceipt", reflect.TypeOf((*MockClientOperator)(nil).WaitForReceipt), ctx, txHash)
+}
+
+// WaitForConfirmedReceipt mocks base method
+func (m *MockClientOperator) WaitForConfirmedReceipt(ctx context.Context, txHash types.Hash, maxRevertRate float64) (*types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForConfirmedReceipt", ctx, txHash, maxRevertRate)
+	ret0, _ := ret[0].(*types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForConfirmedReceipt indicates an expected call of WaitForConfirmedReceipt
+func (mr *MockClientOperatorMockRecorder) WaitForConfirmedReceipt(ctx, txHash, maxRevertRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForConfirmedReceipt", reflect.TypeOf((*MockClientOperator)(nil).WaitForConfirmedReceipt), ctx, txHash, maxRevertRate)
+}
+
+// WaitForEpochConfirmations mocks base method
+func (m *MockClientOperator) WaitForEpochConfirmations(ctx context.Context, txHash types.Hash, confirmations uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForEpochConfirmations", ctx, txHash, confirmations)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForEpochConfirmations indicates an expected call of WaitForEpochConfirmations
+func (mr *MockClientOperatorMockRecorder) WaitForEpochConfirmations(ctx, txHash, confirmations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForEpochConfirmations", reflect.TypeOf((*MockClientOperator)(nil).WaitForEpochConfirmations), ctx, txHash, confirmations)
+}
+
+// SendTransactionAndWait mocks base method
+func (m *MockClientOperator) SendTransactionAndWait(tx *types.UnsignedTransaction, option ...*types.TransactionWaitOption) (*types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tx}
+	for _, a := range option {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendTransactionAndWait", varargs...)
+	ret0, _ := ret[0].(*types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionAndWait indicates an expected call of SendTransactionAndWait
+func (mr *MockClientOperatorMockRecorder) SendTransactionAndWait(tx interface{}, option ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tx}, option...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionAndWait", reflect.TypeOf((*MockClientOperator)(nil).SendTransactionAndWait), varargs...)
+}
+
 // GetContract mocks base method
 func (m *MockClientOperator) GetContract(abiJSON []byte, deployedAt *types.Address) (*Contract, error) {
 	m.ctrl.T.Helper()
@@ -626,6 +1755,61 @@ func (mr *MockClientOperatorMockRecorder) DeployContract(option, abiJSON, byteco
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployContract", reflect.TypeOf((*MockClientOperator)(nil).DeployContract), varargs...)
 }
 
+// DeployContractWithContext mocks base method
+func (m *MockClientOperator) DeployContractWithContext(ctx context.Context, option *types.ContractDeployOption, abiJSON, bytecode []byte, constroctorParams ...interface{}) *ContractDeployResult {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, option, abiJSON, bytecode}
+	for _, a := range constroctorParams {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployContractWithContext", varargs...)
+	ret0, _ := ret[0].(*ContractDeployResult)
+	return ret0
+}
+
+// DeployContractWithContext indicates an expected call of DeployContractWithContext
+func (mr *MockClientOperatorMockRecorder) DeployContractWithContext(ctx, option, abiJSON, bytecode interface{}, constroctorParams ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, option, abiJSON, bytecode}, constroctorParams...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployContractWithContext", reflect.TypeOf((*MockClientOperator)(nil).DeployContractWithContext), varargs...)
+}
+
+// DeployContractSync mocks base method
+func (m *MockClientOperator) DeployContractSync(option *types.ContractDeployOption, abiJSON, bytecode []byte, constroctorParams ...interface{}) (*Contract, types.Hash, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{option, abiJSON, bytecode}
+	for _, a := range constroctorParams {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployContractSync", varargs...)
+	ret0, _ := ret[0].(*Contract)
+	ret1, _ := ret[1].(types.Hash)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeployContractSync indicates an expected call of DeployContractSync
+func (mr *MockClientOperatorMockRecorder) DeployContractSync(option, abiJSON, bytecode interface{}, constroctorParams ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{option, abiJSON, bytecode}, constroctorParams...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployContractSync", reflect.TypeOf((*MockClientOperator)(nil).DeployContractSync), varargs...)
+}
+
+// BatchGetCode mocks base method
+func (m *MockClientOperator) BatchGetCode(addresses []types.Address, epoch *types.Epoch) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetCode", addresses, epoch)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetCode indicates an expected call of BatchGetCode
+func (mr *MockClientOperatorMockRecorder) BatchGetCode(addresses, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetCode", reflect.TypeOf((*MockClientOperator)(nil).BatchGetCode), addresses, epoch)
+}
+
 // BatchGetTxByHashes mocks base method
 func (m *MockClientOperator) BatchGetTxByHashes(txhashes []types.Hash) (map[types.Hash]*types.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -641,6 +1825,21 @@ func (mr *MockClientOperatorMockRecorder) BatchGetTxByHashes(txhashes interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetTxByHashes", reflect.TypeOf((*MockClientOperator)(nil).BatchGetTxByHashes), txhashes)
 }
 
+// BatchGetTransactionReceipts mocks base method
+func (m *MockClientOperator) BatchGetTransactionReceipts(txHashes []types.Hash) ([]*types.TransactionReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetTransactionReceipts", txHashes)
+	ret0, _ := ret[0].([]*types.TransactionReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetTransactionReceipts indicates an expected call of BatchGetTransactionReceipts
+func (mr *MockClientOperatorMockRecorder) BatchGetTransactionReceipts(txHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetTransactionReceipts", reflect.TypeOf((*MockClientOperator)(nil).BatchGetTransactionReceipts), txHashes)
+}
+
 // BatchGetBlockConfirmationRisk mocks base method
 func (m *MockClientOperator) BatchGetBlockConfirmationRisk(blockhashes []types.Hash) (map[types.Hash]*big.Float, error) {
 	m.ctrl.T.Helper()
@@ -753,6 +1952,36 @@ func (mr *MockAccountManagerOperatorMockRecorder) Import(keyFile, passphrase, ne
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockAccountManagerOperator)(nil).Import), keyFile, passphrase, newPassphrase)
 }
 
+// ImportFromPrivateKey mocks base method
+func (m *MockAccountManagerOperator) ImportFromPrivateKey(hexKey, passphrase string) (types.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportFromPrivateKey", hexKey, passphrase)
+	ret0, _ := ret[0].(types.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportFromPrivateKey indicates an expected call of ImportFromPrivateKey
+func (mr *MockAccountManagerOperatorMockRecorder) ImportFromPrivateKey(hexKey, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFromPrivateKey", reflect.TypeOf((*MockAccountManagerOperator)(nil).ImportFromPrivateKey), hexKey, passphrase)
+}
+
+// ExportPrivateKey mocks base method
+func (m *MockAccountManagerOperator) ExportPrivateKey(address types.Address, passphrase string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPrivateKey", address, passphrase)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPrivateKey indicates an expected call of ExportPrivateKey
+func (mr *MockAccountManagerOperatorMockRecorder) ExportPrivateKey(address, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPrivateKey", reflect.TypeOf((*MockAccountManagerOperator)(nil).ExportPrivateKey), address, passphrase)
+}
+
 // Delete mocks base method
 func (m *MockAccountManagerOperator) Delete(address types.Address, passphrase string) error {
 	m.ctrl.T.Helper()
@@ -942,31 +2171,98 @@ func (mr *MockAccountManagerOperatorMockRecorder) Sign(tx, passphrase interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockAccountManagerOperator)(nil).Sign), tx, passphrase)
 }
 
-// MockrpcRequester is a mock of rpcRequester interface
-type MockrpcRequester struct {
+// SignMessage mocks base method
+func (m *MockAccountManagerOperator) SignMessage(address types.Address, message []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessage", address, message)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMessage indicates an expected call of SignMessage
+func (mr *MockAccountManagerOperatorMockRecorder) SignMessage(address, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockAccountManagerOperator)(nil).SignMessage), address, message)
+}
+
+// MockSigner is a mock of Signer interface
+type MockSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerMockRecorder
+}
+
+// MockSignerMockRecorder is the mock recorder for MockSigner
+type MockSignerMockRecorder struct {
+	mock *MockSigner
+}
+
+// NewMockSigner creates a new mock instance
+func NewMockSigner(ctrl *gomock.Controller) *MockSigner {
+	mock := &MockSigner{ctrl: ctrl}
+	mock.recorder = &MockSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSigner) EXPECT() *MockSignerMockRecorder {
+	return m.recorder
+}
+
+// Address mocks base method
+func (m *MockSigner) Address() types.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Address")
+	ret0, _ := ret[0].(types.Address)
+	return ret0
+}
+
+// Address indicates an expected call of Address
+func (mr *MockSignerMockRecorder) Address() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Address", reflect.TypeOf((*MockSigner)(nil).Address))
+}
+
+// SignTransaction mocks base method
+func (m *MockSigner) SignTransaction(tx types.UnsignedTransaction) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction
+func (mr *MockSignerMockRecorder) SignTransaction(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockSigner)(nil).SignTransaction), tx)
+}
+
+// MockRPCRequester is a mock of RPCRequester interface
+type MockRPCRequester struct {
 	ctrl     *gomock.Controller
-	recorder *MockrpcRequesterMockRecorder
+	recorder *MockRPCRequesterMockRecorder
 }
 
-// MockrpcRequesterMockRecorder is the mock recorder for MockrpcRequester
-type MockrpcRequesterMockRecorder struct {
-	mock *MockrpcRequester
+// MockRPCRequesterMockRecorder is the mock recorder for MockRPCRequester
+type MockRPCRequesterMockRecorder struct {
+	mock *MockRPCRequester
 }
 
-// NewMockrpcRequester creates a new mock instance
-func NewMockrpcRequester(ctrl *gomock.Controller) *MockrpcRequester {
-	mock := &MockrpcRequester{ctrl: ctrl}
-	mock.recorder = &MockrpcRequesterMockRecorder{mock}
+// NewMockRPCRequester creates a new mock instance
+func NewMockRPCRequester(ctrl *gomock.Controller) *MockRPCRequester {
+	mock := &MockRPCRequester{ctrl: ctrl}
+	mock.recorder = &MockRPCRequesterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockrpcRequester) EXPECT() *MockrpcRequesterMockRecorder {
+func (m *MockRPCRequester) EXPECT() *MockRPCRequesterMockRecorder {
 	return m.recorder
 }
 
 // Call mocks base method
-func (m *MockrpcRequester) Call(resultPtr interface{}, method string, args ...interface{}) error {
+func (m *MockRPCRequester) Call(resultPtr interface{}, method string, args ...interface{}) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{resultPtr, method}
 	for _, a := range args {
@@ -978,14 +2274,14 @@ func (m *MockrpcRequester) Call(resultPtr interface{}, method string, args ...in
 }
 
 // Call indicates an expected call of Call
-func (mr *MockrpcRequesterMockRecorder) Call(resultPtr, method interface{}, args ...interface{}) *gomock.Call {
+func (mr *MockRPCRequesterMockRecorder) Call(resultPtr, method interface{}, args ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{resultPtr, method}, args...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockrpcRequester)(nil).Call), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockRPCRequester)(nil).Call), varargs...)
 }
 
 // BatchCall mocks base method
-func (m *MockrpcRequester) BatchCall(b []rpc.BatchElem) error {
+func (m *MockRPCRequester) BatchCall(b []rpc.BatchElem) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BatchCall", b)
 	ret0, _ := ret[0].(error)
@@ -993,19 +2289,64 @@ func (m *MockrpcRequester) BatchCall(b []rpc.BatchElem) error {
 }
 
 // BatchCall indicates an expected call of BatchCall
-func (mr *MockrpcRequesterMockRecorder) BatchCall(b interface{}) *gomock.Call {
+func (mr *MockRPCRequesterMockRecorder) BatchCall(b interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCall", reflect.TypeOf((*MockrpcRequester)(nil).BatchCall), b)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCall", reflect.TypeOf((*MockRPCRequester)(nil).BatchCall), b)
 }
 
 // Close mocks base method
-func (m *MockrpcRequester) Close() {
+func (m *MockRPCRequester) Close() error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Close")
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Close indicates an expected call of Close
-func (mr *MockrpcRequesterMockRecorder) Close() *gomock.Call {
+func (mr *MockRPCRequesterMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRPCRequester)(nil).Close))
+}
+
+// MockrpcSubscriber is a mock of rpcSubscriber interface
+type MockrpcSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockrpcSubscriberMockRecorder
+}
+
+// MockrpcSubscriberMockRecorder is the mock recorder for MockrpcSubscriber
+type MockrpcSubscriberMockRecorder struct {
+	mock *MockrpcSubscriber
+}
+
+// NewMockrpcSubscriber creates a new mock instance
+func NewMockrpcSubscriber(ctrl *gomock.Controller) *MockrpcSubscriber {
+	mock := &MockrpcSubscriber{ctrl: ctrl}
+	mock.recorder = &MockrpcSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrpcSubscriber) EXPECT() *MockrpcSubscriberMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method
+func (m *MockrpcSubscriber) Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, namespace, channel}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Subscribe", varargs...)
+	ret0, _ := ret[0].(*rpc.ClientSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockrpcSubscriberMockRecorder) Subscribe(ctx, namespace, channel interface{}, args ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockrpcRequester)(nil).Close))
+	varargs := append([]interface{}{ctx, namespace, channel}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockrpcSubscriber)(nil).Subscribe), varargs...)
 }
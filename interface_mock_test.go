@@ -0,0 +1,16 @@
+package sdk
+
+// The generated mocks should be regenerated by mockgen in the same change as interface.go,
+// these assertions fail the build of tests if any of them is stale.
+var (
+	_ HTTPRequester          = (*MockHTTPRequester)(nil)
+	_ Contractor             = (*MockContractor)(nil)
+	_ ClientOperator         = (*MockClientOperator)(nil)
+	_ AccountManagerOperator = (*MockAccountManagerOperator)(nil)
+	_ Signer                 = (*MockSigner)(nil)
+	_ RPCRequester           = (*MockRPCRequester)(nil)
+	_ rpcSubscriber          = (*MockrpcSubscriber)(nil)
+)
+
+// The setters of Client should be exposed by ClientOperator as well, so that they are mockable.
+var _ ClientOperator = (*Client)(nil)
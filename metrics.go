@@ -0,0 +1,83 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+)
+
+// MetricsObserver observes the JSON-RPC requests issued by client, such as for exporting the per-method latency,
+// error and retry counts to a monitoring system. Set it by Client.SetMetricsObserver.
+//
+// The methods are called synchronously in the goroutine issuing the request, so they should return quickly.
+type MetricsObserver interface {
+	// ObserveCall is called when a request is done, duration includes the retries and err is nil if succeeded.
+	// For batch requests, it is called for each element with the duration of the whole batch, and err is the
+	// error of element or the I/O error of batch.
+	ObserveCall(method string, duration time.Duration, err error)
+	// ObserveRetry is called before retrying the failed request, attempt starts from 1 and err is the error
+	// of the last attempt. The method is "batch" for batch requests.
+	ObserveRetry(method string, attempt int, err error)
+}
+
+// batchMethod is the method name observed by MetricsObserver.ObserveRetry for batch requests.
+const batchMethod = "batch"
+
+// SetMetricsObserver sets the observer which is notified of every JSON-RPC request of client and its retries,
+// it should be set before issuing requests. There is no overhead if observer is nil, which is the default.
+func (client *Client) SetMetricsObserver(observer MetricsObserver) {
+	client.settingsMu.Lock()
+	client.metrics = observer
+	client.settingsMu.Unlock()
+
+	if r, ok := client.rpcRequester.(*rpcClientWithRetry); ok {
+		r.setMetricsObserver(observer)
+	}
+}
+
+// metricsObserver returns the observer set by SetMetricsObserver, or nil if not set.
+func (client *Client) metricsObserver() MetricsObserver {
+	client.settingsMu.RLock()
+	defer client.settingsMu.RUnlock()
+	return client.metrics
+}
+
+// observedCall performs the JSON-RPC call by requester and notifies the metrics observer if set.
+func (client *Client) observedCall(requester RPCRequester, result interface{}, method string, args ...interface{}) error {
+	metrics := client.metricsObserver()
+	if metrics == nil {
+		return toRPCError(requester.Call(result, method, args...))
+	}
+
+	clock := client.getClock()
+	start := clock.Now()
+	err := toRPCError(requester.Call(result, method, args...))
+	metrics.ObserveCall(method, clock.Now().Sub(start), err)
+	return err
+}
+
+// observedBatchCall performs the batch JSON-RPC call by requester and notifies the metrics observer if set.
+func (client *Client) observedBatchCall(requester RPCRequester, b []rpc.BatchElem) error {
+	metrics := client.metricsObserver()
+	if metrics == nil {
+		return requester.BatchCall(b)
+	}
+
+	clock := client.getClock()
+	start := clock.Now()
+	err := requester.BatchCall(b)
+	duration := clock.Now().Sub(start)
+
+	for i := range b {
+		elemErr := err
+		if elemErr == nil {
+			elemErr = toRPCError(b[i].Error)
+		}
+		metrics.ObserveCall(b[i].Method, duration, elemErr)
+	}
+	return err
+}
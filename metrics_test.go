@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type observedCall struct {
+	method   string
+	duration time.Duration
+	err      error
+}
+
+type observedRetry struct {
+	method  string
+	attempt int
+}
+
+// recordingObserver is a MetricsObserver which records all observations.
+type recordingObserver struct {
+	mu      sync.Mutex
+	calls   []observedCall
+	retries []observedRetry
+}
+
+func (o *recordingObserver) ObserveCall(method string, duration time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls = append(o.calls, observedCall{method, duration, err})
+}
+
+func (o *recordingObserver) ObserveRetry(method string, attempt int, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.retries = append(o.retries, observedRetry{method, attempt})
+}
+
+func TestMetricsObserver(t *testing.T) {
+
+	Convey("Subject: Observe rpc requests", t, func() {
+		observer := &recordingObserver{}
+
+		Convey("Given a client retries 3 times every second on a node failing twice", func() {
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      newFlakyRequester(2),
+				retryCount: 3,
+				interval:   time.Second,
+			})
+			client.SetClock(newFakeClock())
+			client.SetMetricsObserver(observer)
+
+			Convey("When call the node", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Observe the call with its total duration and each retry", func() {
+					So(err, ShouldBeNil)
+					So(observer.calls, ShouldResemble, []observedCall{{"cfx_gasPrice", 2 * time.Second, nil}})
+					So(observer.retries, ShouldResemble, []observedRetry{{"cfx_gasPrice", 1}, {"cfx_gasPrice", 2}})
+				})
+			})
+		})
+
+		Convey("Given a batch request with a failed element", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getBalance").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return "0x1", nil
+			})
+			requester.OnAny("cfx_getCode").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return nil, errors.New("invalid address")
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+			client.SetMetricsObserver(observer)
+
+			Convey("When batch call the node", func() {
+				var balance, code string
+				err := client.BatchCallRPC([]rpc.BatchElem{
+					{Method: "cfx_getBalance", Result: &balance},
+					{Method: "cfx_getCode", Result: &code},
+				})
+
+				Convey("Observe each element with its error", func() {
+					So(err, ShouldBeNil)
+					So(len(observer.calls), ShouldEqual, 2)
+					So(observer.calls[0].method, ShouldEqual, "cfx_getBalance")
+					So(observer.calls[0].err, ShouldBeNil)
+					So(observer.calls[1].method, ShouldEqual, "cfx_getCode")
+					So(observer.calls[1].err, ShouldNotBeNil)
+				})
+			})
+		})
+	})
+}
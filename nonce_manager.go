@@ -0,0 +1,204 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// NonceManager tracks the next nonce of accounts locally, so that transactions sent concurrently
+// from the same account get different nonces. Set it to client by Client.SetNonceManager.
+//
+// A nonce handed out by Next is reserved for the transaction being sent. It is consumed once the transaction is
+// submitted successfully, otherwise it is given back by Release and handed out again, so that no nonce gap is left.
+// The client releases the nonce itself if the transaction fails before broadcasted, such as failed to sign.
+// If sending the transaction fails, the node may have received it, so the nonce is only released if the node
+// rejected the transaction, and the local nonce is synchronized with node again before the next nonce is handed out.
+//
+// The local nonce is synchronized with the one fetched from conflux node when it is used first time,
+// when the sync interval elapsed, after sending failed or after Reset is called, and the local nonce is kept if
+// it is ahead of node because of the transactions not executed yet. It is safe for concurrent use.
+type NonceManager struct {
+	mu           sync.Mutex
+	accounts     map[types.Address]*accountNonce
+	syncInterval time.Duration
+}
+
+type accountNonce struct {
+	mu   sync.Mutex
+	next *big.Int
+	// released holds the nonces less than next which are given back, they are handed out before next
+	released []*big.Int
+	syncedAt time.Time
+	// stale is set if sending a transaction failed, the local nonce is synchronized with node on next use
+	stale bool
+}
+
+// NewNonceManager creates a NonceManager which synchronizes the local nonce of an account
+// with conflux node every syncInterval, pass 0 means only synchronize when needed.
+func NewNonceManager(syncInterval time.Duration) *NonceManager {
+	return &NonceManager{
+		accounts:     make(map[types.Address]*accountNonce),
+		syncInterval: syncInterval,
+	}
+}
+
+// account returns the nonce state of address, the address is matched regardless of the checksum casing.
+func (m *NonceManager) account(address types.Address) *accountNonce {
+	key := types.Address(strings.ToLower(string(address)))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	an, ok := m.accounts[key]
+	if !ok {
+		an = new(accountNonce)
+		m.accounts[key] = an
+	}
+	return an
+}
+
+// Next reserves and returns the nonce for the next transaction of address, that is the lowest released nonce
+// if any, otherwise the local nonce which is increased then. Call Release if the transaction is not submitted.
+func (m *NonceManager) Next(client ClientOperator, address types.Address) (*big.Int, error) {
+	an := m.account(address)
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	clock := clockOrDefault(nil)
+	if c, ok := client.(*Client); ok {
+		clock = c.getClock()
+	}
+
+	if an.next == nil || an.stale || (m.syncInterval > 0 && clock.Now().Sub(an.syncedAt) >= m.syncInterval) {
+		nonce, err := client.GetNextNonce(address, nil)
+		if err != nil {
+			msg := fmt.Sprintf("get nonce of {%+v} error", address)
+			return nil, types.WrapError(err, msg)
+		}
+		an.sync(nonce)
+		an.syncedAt = clock.Now()
+		an.stale = false
+	}
+
+	if len(an.released) > 0 {
+		nonce := an.released[0]
+		an.released = an.released[1:]
+		return nonce, nil
+	}
+
+	nonce := new(big.Int).Set(an.next)
+	an.next.Add(an.next, big.NewInt(1))
+	return nonce, nil
+}
+
+// sync merges the nonce fetched from node, the local nonce is kept if it is ahead of node, and the released
+// nonces already used on node are dropped.
+func (an *accountNonce) sync(nonce *big.Int) {
+	if an.next == nil || nonce.Cmp(an.next) > 0 {
+		an.next = new(big.Int).Set(nonce)
+	}
+
+	var released []*big.Int
+	for _, v := range an.released {
+		if v.Cmp(nonce) >= 0 && v.Cmp(an.next) < 0 {
+			released = append(released, v)
+		}
+	}
+	an.released = released
+}
+
+// Release gives back the nonce reserved by Next for a transaction which is not submitted, such as failed to sign
+// or send, so that it is handed out again for the next transaction of address.
+func (m *NonceManager) Release(address types.Address, nonce *big.Int) {
+	if nonce == nil {
+		return
+	}
+
+	an := m.account(address)
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	if an.next == nil || nonce.Cmp(an.next) >= 0 {
+		return
+	}
+	for _, v := range an.released {
+		if v.Cmp(nonce) == 0 {
+			return
+		}
+	}
+
+	an.released = append(an.released, new(big.Int).Set(nonce))
+	sort.Slice(an.released, func(i, j int) bool { return an.released[i].Cmp(an.released[j]) < 0 })
+
+	// shrink the local nonce if the highest nonces handed out are all released
+	for len(an.released) > 0 {
+		last := len(an.released) - 1
+		if new(big.Int).Sub(an.next, an.released[last]).Cmp(big.NewInt(1)) != 0 {
+			break
+		}
+		an.next.Set(an.released[last])
+		an.released = an.released[:last]
+	}
+}
+
+// invalidate marks the local nonce of address stale, it is synchronized with conflux node before the next nonce
+// is handed out, so that the nonces already used on node, such as rejected as too stale, are not handed out again.
+func (m *NonceManager) invalidate(address types.Address) {
+	an := m.account(address)
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	an.stale = true
+}
+
+// Reset drops the local nonce of address, the next nonce will be fetched from conflux node.
+// Call it to recover after transactions are dropped by node, and only when no transaction of address is being sent,
+// otherwise the nonces reserved by them may be handed out again.
+func (m *NonceManager) Reset(address types.Address) {
+	an := m.account(address)
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	an.next = nil
+	an.released = nil
+	an.stale = false
+}
+
+// nonceAllocation is the nonce allocated by a nonce manager for a transaction being sent.
+// The methods are no-op on a nil nonceAllocation, which means no nonce is allocated.
+type nonceAllocation struct {
+	manager *NonceManager
+	address types.Address
+	nonce   *big.Int
+}
+
+// release gives back the nonce, call it if the transaction fails before broadcasted.
+func (a *nonceAllocation) release() {
+	if a == nil {
+		return
+	}
+	a.manager.Release(a.address, a.nonce)
+}
+
+// sendFailed handles the nonce after the transaction is failed to send. The nonce is released only if the node
+// rejected the transaction, because otherwise the node may have received it, and the local nonce is marked stale
+// either way, so that it is synchronized with node before handed out again.
+func (a *nonceAllocation) sendFailed(rejected bool) {
+	if a == nil {
+		return
+	}
+	if rejected {
+		a.manager.Release(a.address, a.nonce)
+	}
+	a.manager.invalidate(a.address)
+}
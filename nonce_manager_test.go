@@ -0,0 +1,299 @@
+package sdk
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNonceManager(t *testing.T) {
+
+	Convey("Subject: Allocate nonces by nonce manager", t, func() {
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0x10", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		manager := NewNonceManager(0)
+		address := types.Address("0x19f4bcf113e0b896d9b34294fd3da86b4adf0302")
+
+		Convey("When allocating concurrently", func() {
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			allocated := make(map[uint64]bool)
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					nonce, err := manager.Next(client, address)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					mu.Lock()
+					allocated[nonce.Uint64()] = true
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			Convey("Return distinct sequential nonces", func() {
+				So(len(allocated), ShouldEqual, 20)
+				for i := uint64(0x10); i < 0x10+20; i++ {
+					So(allocated[i], ShouldBeTrue)
+				}
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When allocating for the address in different casings", func() {
+			first, _ := manager.Next(client, address)
+			second, err := manager.Next(client, types.Address("0x19F4bcf113E0b896d9B34294fd3dA86B4adf0302"))
+
+			Convey("Share the nonces of the same account", func() {
+				So(err, ShouldBeNil)
+				So(first.Uint64(), ShouldEqual, 0x10)
+				So(second.Uint64(), ShouldEqual, 0x11)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the last nonce handed out is released", func() {
+			manager.Next(client, address)
+			nonce, _ := manager.Next(client, address)
+			manager.Release(address, nonce)
+			next, err := manager.Next(client, address)
+
+			Convey("It is handed out again", func() {
+				So(err, ShouldBeNil)
+				So(next.Uint64(), ShouldEqual, 0x11)
+			})
+		})
+
+		Convey("When a nonce before others in flight is released", func() {
+			first, _ := manager.Next(client, address)
+			manager.Next(client, address)
+			manager.Release(address, first)
+			reused, _ := manager.Next(client, address)
+			next, _ := manager.Next(client, address)
+
+			Convey("The gap is filled before increasing", func() {
+				So(reused.Uint64(), ShouldEqual, 0x10)
+				So(next.Uint64(), ShouldEqual, 0x12)
+			})
+		})
+
+		Convey("When reset", func() {
+			manager.Next(client, address)
+			manager.Next(client, address)
+			manager.Reset(address)
+			nonce, err := manager.Next(client, address)
+
+			Convey("Return the nonce fetched from node", func() {
+				So(err, ShouldBeNil)
+				So(nonce.Uint64(), ShouldEqual, 0x10)
+			})
+		})
+	})
+
+	Convey("Subject: Synchronize nonces with node periodically", t, func() {
+		requester := sdktest.NewMockRequester()
+		nodeNonce := "0x10"
+		requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nodeNonce, nil
+		})
+		clock := newFakeClock()
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetClock(clock)
+		manager := NewNonceManager(time.Minute)
+		address := types.Address("0x19f4bcf113e0b896d9b34294fd3da86b4adf0302")
+
+		manager.Next(client, address)
+		manager.Next(client, address)
+
+		Convey("When the node is behind the local nonce after sync interval", func() {
+			clock.Sleep(time.Minute)
+			nonce, _ := manager.Next(client, address)
+
+			Convey("The local nonce is kept", func() {
+				So(nonce.Uint64(), ShouldEqual, 0x12)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the node is ahead of the local nonce after sync interval", func() {
+			nodeNonce = "0x20"
+			clock.Sleep(time.Minute)
+			nonce, _ := manager.Next(client, address)
+
+			Convey("The node nonce is used", func() {
+				So(nonce.Uint64(), ShouldEqual, 0x20)
+			})
+		})
+
+		Convey("When the sync interval is not elapsed", func() {
+			nodeNonce = "0x20"
+			clock.Sleep(time.Second)
+			nonce, _ := manager.Next(client, address)
+
+			Convey("The node is not queried", func() {
+				So(nonce.Uint64(), ShouldEqual, 0x12)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestSendTransactionReleasesNonce(t *testing.T) {
+
+	Convey("Subject: Handle the nonce allocated by nonce manager on failure", t, func() {
+		requester := sdktest.NewMockRequester()
+		nodeNonce := "0x10"
+		requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return nodeNonce, nil
+		})
+		var sendErr error = &fakeJSONError{-32602, "transaction pool is full", nil}
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			if sendErr != nil {
+				return nil, sendErr
+			}
+			return "0xa1", nil
+		})
+		accountManager := &stubAccountManager{}
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetAccountManager(accountManager)
+		client.SetNonceManager(NewNonceManager(0))
+
+		newTx := func() *types.UnsignedTransaction {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			return tx
+		}
+
+		Convey("When the transaction is rejected by node", func() {
+			failed := newTx()
+			_, err := client.SendTransaction(failed)
+			So(err, ShouldNotBeNil)
+
+			sendErr = nil
+			next := newTx()
+			_, err = client.SendTransaction(next)
+
+			Convey("The nonce is allocated to the next transaction after synchronized with node", func() {
+				So(err, ShouldBeNil)
+				So(failed.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(next.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the nonce is rejected as too stale", func() {
+			failed := newTx()
+			sendErr = &fakeJSONError{-32602, "Transaction 0xa1 is discarded due to a too stale nonce", nil}
+			_, err := client.SendTransaction(failed)
+			So(err, ShouldNotBeNil)
+
+			// the account is used by others meanwhile
+			nodeNonce = "0x12"
+			sendErr = nil
+			next := newTx()
+			_, err = client.SendTransaction(next)
+
+			Convey("The stale nonce is not handed out again", func() {
+				So(err, ShouldBeNil)
+				So(failed.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(next.Nonce.ToInt().Uint64(), ShouldEqual, 0x12)
+			})
+		})
+
+		Convey("When the connection is lost after the node received the transaction", func() {
+			failed := newTx()
+			sendErr = errors.New("read tcp 127.0.0.1:12537: i/o timeout")
+			_, err := client.SendTransaction(failed)
+			So(err, ShouldNotBeNil)
+
+			// the transaction is pending in node, so the nonce of node is not increased
+			sendErr = nil
+			next := newTx()
+			_, err = client.SendTransaction(next)
+
+			Convey("The nonce is not handed out again", func() {
+				So(err, ShouldBeNil)
+				So(failed.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(next.Nonce.ToInt().Uint64(), ShouldEqual, 0x11)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the default fields are failed to apply", func() {
+			failed := newTx()
+			failed.Gas = nil
+			_, err := client.SendTransaction(failed)
+
+			Convey("No nonce is allocated", func() {
+				So(err, ShouldNotBeNil)
+				So(failed.Nonce, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_getNextNonce")), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When sign only", func() {
+			signed := newTx()
+			_, _, err := client.SignTransaction(signed)
+			So(err, ShouldBeNil)
+
+			sendErr = nil
+			next := newTx()
+			_, err = client.SendTransaction(next)
+
+			Convey("The nonce is fetched from node without allocated by nonce manager", func() {
+				So(err, ShouldBeNil)
+				So(signed.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(next.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+			})
+		})
+
+		Convey("When the transaction is failed to sign", func() {
+			accountManager.signErr = errors.New("account is locked")
+			failed := newTx()
+			_, err := client.SendTransaction(failed)
+			So(err, ShouldNotBeNil)
+
+			accountManager.signErr = nil
+			sendErr = nil
+			next := newTx()
+			_, err = client.SendTransaction(next)
+
+			Convey("The nonce is allocated to the next transaction", func() {
+				So(err, ShouldBeNil)
+				So(failed.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+				So(next.Nonce.ToInt().Uint64(), ShouldEqual, 0x10)
+			})
+		})
+
+	})
+}
+
+// stubAccountManager is an AccountManagerOperator which signs transactions without keystore,
+// it fails to sign if signErr is set.
+type stubAccountManager struct {
+	AccountManagerOperator
+	signErr error
+}
+
+func (m *stubAccountManager) SignTransaction(tx types.UnsignedTransaction) ([]byte, error) {
+	if m.signErr != nil {
+		return nil, m.signErr
+	}
+	return tx.Encode()
+}
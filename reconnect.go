@@ -0,0 +1,179 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// ErrReconnectNotSupported is returned by Client.Reconnect if the client is not created with a node url,
+// such as created by NewClientWithRPCRequester.
+var ErrReconnectNotSupported = errors.New("reconnect is not supported by the rpc requester")
+
+// redialer is implemented by rpc requesters which could re-establish the connection to conflux node.
+type redialer interface {
+	Redial() error
+	// redialUnlessSwitched re-dials only if the connection is not switched since the switches are observed,
+	// so that the requests failed on the same broken connection at once re-dial only once.
+	redialUnlessSwitched(switches uint64) error
+}
+
+// connectionSwitcher is implemented by rpc requesters whose connection to conflux node may be switched,
+// such as re-dialed or failed over to another endpoint.
+type connectionSwitcher interface {
+	// switches returns the times the connection is switched
+	switches() uint64
+}
+
+// redialingRequester is the RPCRequester of a connection to conflux node, which replaces the connection
+// with a newly dialed one when the connection is broken.
+type redialingRequester struct {
+	// redialMu serializes redials, while mu guards the fields
+	redialMu sync.Mutex
+	mu       sync.RWMutex
+	inner    RPCRequester
+	dial     func() (RPCRequester, error)
+	closed   bool
+	// redials counts the connections replaced
+	redials uint64
+}
+
+// newRedialingRequester dials by dial and returns the redialingRequester of the connection.
+func newRedialingRequester(dial func() (RPCRequester, error)) (*redialingRequester, error) {
+	inner, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	return &redialingRequester{inner: inner, dial: dial}, nil
+}
+
+func (r *redialingRequester) current() RPCRequester {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.inner
+}
+
+// Redial dials a new connection and closes the current one, the requests in flight on the current connection
+// may fail, while the subsequent requests are sent on the new connection.
+func (r *redialingRequester) Redial() error {
+	r.redialMu.Lock()
+	defer r.redialMu.Unlock()
+	return r.redial()
+}
+
+func (r *redialingRequester) redialUnlessSwitched(switches uint64) error {
+	r.redialMu.Lock()
+	defer r.redialMu.Unlock()
+
+	if r.switches() != switches {
+		return nil
+	}
+	return r.redial()
+}
+
+// redial replaces the current connection with a newly dialed one, it must be called with redialMu held.
+func (r *redialingRequester) redial() error {
+	inner, err := r.dial()
+	if err != nil {
+		return types.WrapError(err, "redial failed")
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		inner.Close()
+		return ErrClientShutdown
+	}
+	old := r.inner
+	r.inner = inner
+	r.redials++
+	r.mu.Unlock()
+
+	old.Close()
+	return nil
+}
+
+func (r *redialingRequester) switches() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.redials
+}
+
+func (r *redialingRequester) Call(resultPtr interface{}, method string, args ...interface{}) error {
+	return r.current().Call(resultPtr, method, args...)
+}
+
+func (r *redialingRequester) BatchCall(b []rpc.BatchElem) error {
+	return r.current().BatchCall(b)
+}
+
+func (r *redialingRequester) Subscribe(ctx context.Context, namespace string, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
+	subscriber, ok := r.current().(rpcSubscriber)
+	if !ok {
+		return nil, errors.New("subscription is not supported by the inner rpc requester")
+	}
+	return subscriber.Subscribe(ctx, namespace, channel, args...)
+}
+
+func (r *redialingRequester) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	inner := r.inner
+	r.mu.Unlock()
+
+	return inner.Close()
+}
+
+// IsConnectionError returns true if err is caused by a broken connection to conflux node,
+// such as the connection is reset or closed by node, which could be recovered by reconnecting.
+func IsConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, rpc.ErrClientQuit) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && !opErr.Timeout()
+}
+
+// Reconnect re-dials the node url of client and replaces the underlying connection, which is useful to
+// recover from a broken connection, such as after conflux node restarts.
+//
+// The connection is also reconnected automatically before retrying a request failed by connection error
+// if client is created with retry.
+func (client *Client) Reconnect() error {
+	requester := client.rpcRequester
+	if r, ok := requester.(*rpcClientWithRetry); ok {
+		requester = r.inner
+	}
+
+	dialer, ok := requester.(redialer)
+	if !ok {
+		return ErrReconnectNotSupported
+	}
+
+	if err := dialer.Redial(); err != nil {
+		msg := fmt.Sprintf("reconnect to %v error", client.nodeURL)
+		return types.WrapError(err, msg)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReconnect(t *testing.T) {
+
+	Convey("Subject: Reconnect broken connection", t, func() {
+		var dialed []*sdktest.MockRequester
+		dial := func() (RPCRequester, error) {
+			requester := sdktest.NewMockRequester()
+			if len(dialed) == 0 {
+				// the first connection is broken
+				requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+					return nil, rpc.ErrClientQuit
+				})
+			} else {
+				requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+					return "0x1", nil
+				})
+			}
+			dialed = append(dialed, requester)
+			return requester, nil
+		}
+
+		Convey("Given a client with retry", func() {
+			conn, _ := newRedialingRequester(dial)
+			client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+				inner:      conn,
+				retryCount: 1,
+				interval:   time.Millisecond,
+			})
+
+			Convey("When call on a broken connection", func() {
+				var result string
+				err := client.CallRPC(&result, "cfx_gasPrice")
+
+				Convey("Reconnect before retry and close the broken connection", func() {
+					So(err, ShouldBeNil)
+					So(result, ShouldEqual, "0x1")
+					So(len(dialed), ShouldEqual, 2)
+					So(dialed[0].CloseCount(), ShouldEqual, 1)
+				})
+			})
+
+			Convey("When reconnect manually", func() {
+				So(client.Reconnect(), ShouldBeNil)
+
+				var result string
+				err := client.CallRPCWithOption(&result, &CallOption{}, "cfx_gasPrice")
+
+				Convey("The new connection is used", func() {
+					So(err, ShouldBeNil)
+					So(len(dialed), ShouldEqual, 2)
+					So(len(dialed[0].CallsOf("cfx_gasPrice")), ShouldEqual, 0)
+				})
+			})
+
+			Convey("When reconnect after client closed", func() {
+				client.Close()
+				err := client.Reconnect()
+
+				Convey("Return ErrClientShutdown and close the new connection", func() {
+					So(errors.Is(err, ErrClientShutdown), ShouldBeTrue)
+					So(dialed[1].CloseCount(), ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("Given a client without reconnect support", func() {
+			client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+
+			Convey("Reconnect returns ErrReconnectNotSupported", func() {
+				So(errors.Is(client.Reconnect(), ErrReconnectNotSupported), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestReconnectConcurrently(t *testing.T) {
+
+	Convey("Subject: Reconnect once when concurrent calls fail on the same broken connection", t, func() {
+		const calls = 8
+
+		// all calls reach the broken connection before any of them fails
+		var arrived sync.WaitGroup
+		arrived.Add(calls)
+
+		var mu sync.Mutex
+		var dialed []*sdktest.MockRequester
+		dial := func() (RPCRequester, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			requester := sdktest.NewMockRequester()
+			if len(dialed) == 0 {
+				requester.OnAny("cfx_gasPrice").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+					arrived.Done()
+					arrived.Wait()
+					return nil, rpc.ErrClientQuit
+				})
+			} else {
+				requester.OnAny("cfx_gasPrice").Return("0x1")
+			}
+			dialed = append(dialed, requester)
+			return requester, nil
+		}
+
+		conn, _ := newRedialingRequester(dial)
+		client, _ := NewClientWithRPCRequester(&rpcClientWithRetry{
+			inner:      conn,
+			retryCount: 3,
+			interval:   time.Millisecond,
+		})
+
+		errs := make(chan error, calls)
+		for i := 0; i < calls; i++ {
+			go func() {
+				var result string
+				errs <- client.CallRPC(&result, "cfx_gasPrice")
+			}()
+		}
+		for i := 0; i < calls; i++ {
+			So(<-errs, ShouldBeNil)
+		}
+
+		Convey("The broken connection is re-dialed only once", func() {
+			So(len(dialed), ShouldEqual, 2)
+			So(conn.switches(), ShouldEqual, 1)
+			So(dialed[0].CloseCount(), ShouldEqual, 1)
+			So(len(dialed[1].CallsOf("cfx_gasPrice")), ShouldEqual, calls)
+		})
+	})
+}
+
+func TestIsConnectionError(t *testing.T) {
+
+	Convey("Subject: Detect connection error", t, func() {
+		So(IsConnectionError(nil), ShouldBeFalse)
+		So(IsConnectionError(rpc.ErrClientQuit), ShouldBeTrue)
+		So(IsConnectionError(io.EOF), ShouldBeTrue)
+		So(IsConnectionError(&net.OpError{Op: "read", Err: syscall.ECONNRESET}), ShouldBeTrue)
+		So(IsConnectionError(errors.New("invalid params")), ShouldBeFalse)
+		So(IsConnectionError(&fakeJSONError{-32602, "invalid params", nil}), ShouldBeFalse)
+	})
+}
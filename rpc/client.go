@@ -92,6 +92,7 @@ type Client struct {
 	close       chan struct{}
 	closing     chan struct{}    // closed when client is quitting
 	didClose    chan struct{}    // closed when client quits
+	closeErr    error            // error of closing the connection, set before didClose is closed
 	reconnected chan ServerCodec // where write/reconnect sends the new connection
 	readOp      chan readOp      // read messages
 	readErr     chan error       // errors from read
@@ -115,9 +116,9 @@ func (c *Client) newClientConn(conn ServerCodec) *clientConn {
 	return &clientConn{conn, handler}
 }
 
-func (cc *clientConn) close(err error, inflightReq *requestOp) {
+func (cc *clientConn) close(err error, inflightReq *requestOp) error {
 	cc.handler.close(err, inflightReq)
-	cc.codec.close()
+	return cc.codec.close()
 }
 
 type readOp struct {
@@ -248,16 +249,17 @@ func (c *Client) SupportedModules() (map[string]string, error) {
 	return result, err
 }
 
-// Close closes the client, aborting any in-flight requests.
-func (c *Client) Close() {
-	if c.isHTTP {
-		return
+// Close closes the client, aborting any in-flight requests. It returns the error of closing the connection.
+func (c *Client) Close() error {
+	if c == nil || c.isHTTP {
+		return nil
 	}
 	select {
 	case c.close <- struct{}{}:
 		<-c.didClose
 	case <-c.didClose:
 	}
+	return c.closeErr
 }
 
 // Call performs a JSON-RPC call with the given arguments and unmarshals into
@@ -535,7 +537,7 @@ func (c *Client) dispatch(codec ServerCodec) {
 	defer func() {
 		close(c.closing)
 		if reading {
-			conn.close(ErrClientQuit, nil)
+			c.closeErr = conn.close(ErrClientQuit, nil)
 			c.drainRead()
 		}
 		close(c.didClose)
@@ -39,10 +39,26 @@ const (
 // https://www.jsonrpc.org/historical/json-rpc-over-http.html#id13
 var acceptedContentTypes = []string{contentType, "application/json-rpc", "application/jsonrequest"}
 
+// HTTPError is returned by client operations when the HTTP status code of the
+// response is not a 2xx status.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (err HTTPError) Error() string {
+	if len(err.Body) == 0 {
+		return err.Status
+	}
+	return fmt.Sprintf("%v: %s", err.Status, err.Body)
+}
+
 type httpConn struct {
 	// client    *http.Client
 	// req       *http.Request
 	client    *fasthttp.Client
+	mu        sync.Mutex // protects req headers
 	req       *fasthttp.Request
 	closeOnce sync.Once
 	closeCh   chan interface{}
@@ -62,8 +78,9 @@ func (hc *httpConn) readBatch() ([]*jsonrpcMessage, bool, error) {
 	return nil, false, io.EOF
 }
 
-func (hc *httpConn) close() {
+func (hc *httpConn) close() error {
 	hc.closeOnce.Do(func() { close(hc.closeCh) })
+	return nil
 }
 
 func (hc *httpConn) closed() <-chan interface{} {
@@ -129,6 +146,19 @@ func DialHTTPWithClient(endpoint string, client *fasthttp.Client) (*Client, erro
 	})
 }
 
+// SetHeader adds a custom HTTP header to the client's requests, such as the Authorization header
+// required by node providers. This method only works for clients using HTTP, it doesn't have
+// any effect for clients using another transport.
+func (c *Client) SetHeader(key, value string) {
+	if !c.isHTTP {
+		return
+	}
+	conn := c.writeConn.(*httpConn)
+	conn.mu.Lock()
+	conn.req.Header.Set(key, value)
+	conn.mu.Unlock()
+}
+
 // DialHTTP creates a new RPC client that connects to an RPC server over HTTP.
 func DialHTTP(endpoint string) (*Client, error) {
 	return DialHTTPWithClient(endpoint, new(fasthttp.Client))
@@ -188,7 +218,9 @@ func (hc *httpConn) doRequest(ctx context.Context, msg interface{}) (io.Reader,
 	// req.ContentLength = int64(len(body))
 
 	req := &fasthttp.Request{}
+	hc.mu.Lock()
 	hc.req.CopyTo(req)
+	hc.mu.Unlock()
 	req.SetBody(body)
 
 	resp := &fasthttp.Response{}
@@ -199,7 +231,11 @@ func (hc *httpConn) doRequest(ctx context.Context, msg interface{}) (io.Reader,
 	}
 	// fmt.Printf("do request done req:%+v\n respbody:%#v\n\n", req, resp.Body)
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return bytes.NewReader(resp.Body()), errors.New(string(resp.StatusCode()))
+		return nil, HTTPError{
+			StatusCode: resp.StatusCode(),
+			Status:     fmt.Sprintf("%d %s", resp.StatusCode(), fasthttp.StatusMessage(resp.StatusCode())),
+			Body:       resp.Body(),
+		}
 	}
 	return bytes.NewReader(resp.Body()), nil
 }
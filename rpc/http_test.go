@@ -98,3 +98,39 @@ func confirmHTTPRequestYieldsStatusCode(t *testing.T, method, contentType, body
 func TestHTTPResponseWithEmptyGet(t *testing.T) {
 	confirmHTTPRequestYieldsStatusCode(t, http.MethodGet, "", "", http.StatusOK)
 }
+
+func TestHTTPSetHeader(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+
+	var received http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		server.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	client, err := DialHTTP(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	client.SetHeader("Authorization", "Bearer token")
+	client.SetHeader("X-Api-Key", "key")
+
+	var result echoResult
+	if err := client.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := received.Get("Authorization"); got != "Bearer token" {
+		t.Errorf("wrong Authorization header, expect %v, got %v", "Bearer token", got)
+	}
+	if got := received.Get("X-Api-Key"); got != "key" {
+		t.Errorf("wrong X-Api-Key header, expect %v, got %v", "key", got)
+	}
+	if got := received.Get("Content-Type"); got != contentType {
+		t.Errorf("wrong Content-Type header, expect %v, got %v", contentType, got)
+	}
+}
@@ -135,6 +135,10 @@ func (err *jsonError) ErrorCode() int {
 	return err.Code
 }
 
+func (err *jsonError) ErrorData() interface{} {
+	return err.Data
+}
+
 // Conn is a subset of the methods of net.Conn which are sufficient for ServerCodec.
 type Conn interface {
 	io.ReadWriteCloser
@@ -156,13 +160,14 @@ type ConnRemoteAddr interface {
 // jsonCodec reads and writes JSON-RPC messages to the underlying connection. It also has
 // support for parsing arguments and serializing (result) objects.
 type jsonCodec struct {
-	remote  string
-	closer  sync.Once                 // close closed channel once
-	closeCh chan interface{}          // closed on Close
-	decode  func(v interface{}) error // decoder to allow multiple transports
-	encMu   sync.Mutex                // guards the encoder
-	encode  func(v interface{}) error // encoder to allow multiple transports
-	conn    deadlineCloser
+	remote   string
+	closer   sync.Once                 // close closed channel once
+	closeCh  chan interface{}          // closed on Close
+	closeErr error                     // error of closing conn, set by closer
+	decode   func(v interface{}) error // decoder to allow multiple transports
+	encMu    sync.Mutex                // guards the encoder
+	encode   func(v interface{}) error // encoder to allow multiple transports
+	conn     deadlineCloser
 }
 
 // NewFuncCodec creates a codec which uses the given functions to read and write. If conn
@@ -217,11 +222,12 @@ func (c *jsonCodec) writeJSON(ctx context.Context, v interface{}) error {
 	return c.encode(v)
 }
 
-func (c *jsonCodec) close() {
+func (c *jsonCodec) close() error {
 	c.closer.Do(func() {
 		close(c.closeCh)
-		c.conn.Close()
+		c.closeErr = c.conn.Close()
 	})
+	return c.closeErr
 }
 
 // Closed returns a channel which will be closed when Close is called
@@ -0,0 +1,247 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+// Package sdktest provides utilities for unit testing code which uses the go-conflux-sdk without a live node.
+//
+// Create a client with a MockRequester, register canned responses and assert the calls:
+//
+//	requester := sdktest.NewMockRequester()
+//	requester.OnAny("cfx_gasPrice").Return("0x3b9aca00")
+//	requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+//		return "0x1", nil
+//	})
+//	client, _ := sdk.NewClientWithRPCRequester(requester)
+//	...
+//	requester.AssertCalled(t, "cfx_gasPrice")
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+)
+
+// Call records a rpc request received by MockRequester
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Response is the canned response of rpc requests matching a method and optionally its args
+type Response struct {
+	// mu is the mutex of the MockRequester which the response is registered to
+	mu      *sync.Mutex
+	method  string
+	args    []interface{}
+	anyArgs bool
+	result  interface{}
+	err     error
+	fn      func(args ...interface{}) (interface{}, error)
+}
+
+// Return sets the result of matched requests, it is json round-tripped into the result pointer
+// as a real node response would be, so that a JSON compatible value such as "0x1" could be used for *hexutil.Big.
+func (r *Response) Return(result interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result = result
+	r.err = nil
+	r.fn = nil
+}
+
+// ReturnError sets the error of matched requests.
+func (r *Response) ReturnError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result = nil
+	r.err = err
+	r.fn = nil
+}
+
+// ReturnFunc sets fn to answer matched requests by their args, which is useful for the responses changing over
+// calls or depending on args. fn is called without any lock held, so it could inspect the requester.
+func (r *Response) ReturnFunc(fn func(args ...interface{}) (interface{}, error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result = nil
+	r.err = nil
+	r.fn = fn
+}
+
+// MockRequester is a programmable rpc requester which answers requests by the registered responses
+// and records all calls. Pass it to sdk.NewClientWithRPCRequester to create a client. It is safe for concurrent use.
+type MockRequester struct {
+	mu        sync.Mutex
+	responses []*Response
+	calls     []Call
+	closed    int
+}
+
+// NewMockRequester creates a MockRequester without any response registered.
+func NewMockRequester() *MockRequester {
+	return &MockRequester{}
+}
+
+// On registers a response for requests of method with args, args are matched by their JSON encodings.
+// The response registered later takes precedence if several responses match a request.
+func (m *MockRequester) On(method string, args ...interface{}) *Response {
+	return m.register(&Response{method: method, args: args})
+}
+
+// OnAny registers a response for requests of method with any args.
+func (m *MockRequester) OnAny(method string) *Response {
+	return m.register(&Response{method: method, anyArgs: true})
+}
+
+func (m *MockRequester) register(response *Response) *Response {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	response.mu = &m.mu
+	m.responses = append(m.responses, response)
+	return response
+}
+
+// Call implements the rpc requester interface, it returns error if no response is registered for the request.
+func (m *MockRequester) Call(resultPtr interface{}, method string, args ...interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{method, args})
+	response := m.match(method, args)
+	var result interface{}
+	var err error
+	var fn func(args ...interface{}) (interface{}, error)
+	if response != nil {
+		result, err, fn = response.result, response.err, response.fn
+	}
+	m.mu.Unlock()
+
+	if response == nil {
+		return fmt.Errorf("no mock response for method %v with args %+v", method, args)
+	}
+	if fn != nil {
+		result, err = fn(args...)
+	}
+	if err != nil {
+		return err
+	}
+	if resultPtr == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, resultPtr)
+}
+
+// match returns the latest registered response matching the request, it must be called with mu held.
+func (m *MockRequester) match(method string, args []interface{}) *Response {
+	for i := len(m.responses) - 1; i >= 0; i-- {
+		response := m.responses[i]
+		if response.method == method && (response.anyArgs || argsEqual(response.args, args)) {
+			return response
+		}
+	}
+	return nil
+}
+
+// BatchCall implements the rpc requester interface, each element is answered as a single request.
+func (m *MockRequester) BatchCall(b []rpc.BatchElem) error {
+	for i := range b {
+		b[i].Error = m.Call(b[i].Result, b[i].Method, b[i].Args...)
+	}
+	return nil
+}
+
+// Close implements the rpc requester interface
+func (m *MockRequester) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed++
+	return nil
+}
+
+// Closed reports whether Close is called.
+func (m *MockRequester) Closed() bool {
+	return m.CloseCount() > 0
+}
+
+// CloseCount returns the number of times Close is called.
+func (m *MockRequester) CloseCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.closed
+}
+
+// Calls returns all recorded calls in order.
+func (m *MockRequester) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call{}, m.calls...)
+}
+
+// CallsOf returns the recorded calls of method in order.
+func (m *MockRequester) CallsOf(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []Call
+	for _, c := range m.calls {
+		if c.Method == method {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Called reports whether method is called with args, args are matched by their JSON encodings.
+func (m *MockRequester) Called(method string, args ...interface{}) bool {
+	for _, c := range m.CallsOf(method) {
+		if argsEqual(args, c.Args) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssertCalled reports an error to t if method is never called with args.
+func (m *MockRequester) AssertCalled(t testing.TB, method string, args ...interface{}) bool {
+	t.Helper()
+	if !m.Called(method, args...) {
+		t.Errorf("expect %v called with args %+v, actual calls: %+v", method, args, m.CallsOf(method))
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled reports an error to t if method is called with any args.
+func (m *MockRequester) AssertNotCalled(t testing.TB, method string) bool {
+	t.Helper()
+	if calls := m.CallsOf(method); len(calls) > 0 {
+		t.Errorf("expect %v not called, actual calls: %+v", method, calls)
+		return false
+	}
+	return true
+}
+
+// argsEqual reports whether the JSON encodings of expected and actual args are equal,
+// so that values of different go types but the same rpc representation are considered equal.
+func argsEqual(expected, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	expectedJSON, err := json.Marshal(expected)
+	if err != nil {
+		return false
+	}
+	actualJSON, err := json.Marshal(actual)
+	if err != nil {
+		return false
+	}
+	return string(expectedJSON) == string(actualJSON)
+}
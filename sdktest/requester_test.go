@@ -0,0 +1,123 @@
+package sdktest
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestMockRequesterCall(t *testing.T) {
+	address := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+
+	requester := NewMockRequester()
+	requester.OnAny("cfx_getBalance").Return("0x1")
+	requester.On("cfx_getBalance", address, types.EpochLatestState).Return("0x64")
+	requester.OnAny("cfx_gasPrice").ReturnError(errors.New("boom"))
+
+	var balance hexutil.Big
+	if err := requester.Call(&balance, "cfx_getBalance", "0x1cad0b19bb29d4674531d6f115237e16afce377c", "latest_state"); err != nil {
+		t.Fatal(err)
+	}
+	if balance.ToInt().Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("Test matching args failed, expect 100, actual %v", balance.ToInt())
+	}
+
+	if err := requester.Call(&balance, "cfx_getBalance", address); err != nil {
+		t.Fatal(err)
+	}
+	if balance.ToInt().Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("Test matching any args failed, expect 1, actual %v", balance.ToInt())
+	}
+
+	if err := requester.Call(nil, "cfx_gasPrice"); err == nil || err.Error() != "boom" {
+		t.Errorf("Test returning error failed, actual %v", err)
+	}
+
+	if err := requester.Call(nil, "cfx_epochNumber"); err == nil {
+		t.Error("Test calling unregistered method failed, expect error")
+	}
+
+	requester.AssertCalled(t, "cfx_getBalance", address, types.EpochLatestState)
+	requester.AssertNotCalled(t, "cfx_getCode")
+	if requester.Called("cfx_getBalance", address, types.EpochLatestMined) {
+		t.Error("Test Called with unmatched args failed, expect false")
+	}
+	if len(requester.Calls()) != 4 || len(requester.CallsOf("cfx_getBalance")) != 2 {
+		t.Errorf("Test recording calls failed, actual %+v", requester.Calls())
+	}
+}
+
+func TestMockRequesterBatchCall(t *testing.T) {
+	requester := NewMockRequester()
+	requester.On("cfx_getCode", "0x1").Return("0x60")
+
+	var code1, code2 string
+	batch := []rpc.BatchElem{
+		{Method: "cfx_getCode", Args: []interface{}{"0x1"}, Result: &code1},
+		{Method: "cfx_getCode", Args: []interface{}{"0x2"}, Result: &code2},
+	}
+	if err := requester.BatchCall(batch); err != nil {
+		t.Fatal(err)
+	}
+	if batch[0].Error != nil || code1 != "0x60" {
+		t.Errorf("Test batch call failed, expect 0x60, actual %v, error %v", code1, batch[0].Error)
+	}
+	if batch[1].Error == nil {
+		t.Error("Test batch call with unregistered args failed, expect error")
+	}
+
+	requester.Close()
+	requester.Close()
+	if !requester.Closed() || requester.CloseCount() != 2 {
+		t.Errorf("Test Close failed, expect closed twice, actual %v", requester.CloseCount())
+	}
+}
+
+func TestMockRequesterReturnFunc(t *testing.T) {
+	requester := NewMockRequester()
+	requester.OnAny("cfx_getCode").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+		if len(requester.CallsOf("cfx_getCode")) > 1 {
+			return nil, errors.New("boom")
+		}
+		return args[0], nil
+	})
+
+	var code string
+	if err := requester.Call(&code, "cfx_getCode", "0x60"); err != nil || code != "0x60" {
+		t.Errorf("Test returning by func failed, expect 0x60, actual %v, error %v", code, err)
+	}
+	if err := requester.Call(&code, "cfx_getCode", "0x60"); err == nil || err.Error() != "boom" {
+		t.Errorf("Test returning error by func failed, actual %v", err)
+	}
+}
+
+func TestMockRequesterConcurrentResponse(t *testing.T) {
+	requester := NewMockRequester()
+	response := requester.OnAny("cfx_gasPrice")
+	response.Return("0x1")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			var price hexutil.Big
+			requester.Call(&price, "cfx_gasPrice")
+		}()
+		go func() {
+			defer wg.Done()
+			response.Return("0x2")
+		}()
+	}
+	wg.Wait()
+
+	var price hexutil.Big
+	if err := requester.Call(&price, "cfx_gasPrice"); err != nil || price.ToInt().Int64() != 2 {
+		t.Errorf("Test changing response concurrently failed, expect 2, actual %v, error %v", price.ToInt(), err)
+	}
+}
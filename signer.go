@@ -0,0 +1,64 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/Conflux-Chain/go-conflux-sdk/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// accountSigner is the Signer of an account managed by AccountManager
+type accountSigner struct {
+	accountManager *AccountManager
+	address        types.Address
+}
+
+// Signer returns the Signer of the specified account, which must be unlocked before signing.
+func (m *AccountManager) Signer(address types.Address) Signer {
+	return &accountSigner{m, address}
+}
+
+// Address implements the Signer interface
+func (s *accountSigner) Address() types.Address {
+	return s.address
+}
+
+// SignTransaction implements the Signer interface
+func (s *accountSigner) SignTransaction(tx types.UnsignedTransaction) ([]byte, error) {
+	return s.accountManager.SignTransaction(tx)
+}
+
+// PrivateKeySigner is a Signer which signs transactions with a private key in memory, without keystore.
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    types.Address
+}
+
+// NewPrivateKeySigner creates a PrivateKeySigner with privateKey.
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey) *PrivateKeySigner {
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		address:    utils.ToCfxGeneralAddress(crypto.PubkeyToAddress(privateKey.PublicKey)),
+	}
+}
+
+// Address implements the Signer interface
+func (s *PrivateKeySigner) Address() types.Address {
+	return s.address
+}
+
+// SignTransaction implements the Signer interface
+func (s *PrivateKeySigner) SignTransaction(tx types.UnsignedTransaction) ([]byte, error) {
+	signedTx, err := tx.Sign(s.privateKey)
+	if err != nil {
+		msg := fmt.Sprintf("sign tx %+v error", tx)
+		return nil, types.WrapError(err, msg)
+	}
+	return signedTx.Encode()
+}
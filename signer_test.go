@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSetSigner(t *testing.T) {
+
+	Convey("Subject: Send transaction signed by signer", t, func() {
+		privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+		signer := NewPrivateKeySigner(privateKey)
+
+		requester := sdktest.NewMockRequester()
+		requester.OnAny("cfx_sendRawTransaction").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+			return "0xa1", nil
+		})
+		client, _ := NewClientWithRPCRequester(requester)
+		client.SetSigner(signer)
+
+		newTx := func() *types.UnsignedTransaction {
+			tx := &types.UnsignedTransaction{To: types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")}
+			tx.Nonce = types.NewBigInt(1)
+			tx.ChainID = types.NewBigInt(1)
+			tx.GasPrice = types.NewBigInt(1)
+			tx.EpochHeight = types.NewBigInt(100)
+			tx.Gas = types.NewBigInt(21000)
+			tx.StorageLimit = types.NewBigInt(0)
+			return tx
+		}
+
+		Convey("When send a transaction without from", func() {
+			tx := newTx()
+			_, err := client.SendTransaction(tx)
+
+			Convey("The signer address is used as from and the raw transaction is sent", func() {
+				So(err, ShouldBeNil)
+				So(*tx.From, ShouldEqual, types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c"))
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When send a transaction from the checksum address of signer", func() {
+			signerAddress := signer.Address()
+			checksumAddress := types.Address(signerAddress.ToChecksumHex())
+			tx := newTx()
+			tx.From = &checksumAddress
+			_, err := client.SendTransaction(tx)
+
+			Convey("The address is matched case-insensitively and the raw transaction is sent", func() {
+				So(checksumAddress, ShouldNotEqual, signerAddress)
+				So(err, ShouldBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When send a transaction from another address", func() {
+			tx := newTx()
+			tx.From = types.NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+			_, err := client.SendTransaction(tx)
+
+			Convey("Return error without sending", func() {
+				So(err, ShouldNotBeNil)
+				So(len(requester.CallsOf("cfx_sendRawTransaction")), ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,250 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+)
+
+// clientSubscription is a subscription of notifications from conflux node, it is implemented by *rpc.ClientSubscription.
+type clientSubscription interface {
+	Err() <-chan error
+	Unsubscribe()
+}
+
+// reconnecter is implemented by clients which could re-establish the connection to conflux node, such as *Client.
+type reconnecter interface {
+	Reconnect() error
+}
+
+// closingReporter is implemented by clients which report whether they are closed or shutting down, such as *Client.
+type closingReporter interface {
+	isClosing() bool
+}
+
+// Resubscription is delivered by Subscription.Resubscribed after the subscription is re-established
+// on a new connection, because the previous connection is lost.
+type Resubscription struct {
+	// LastEpoch is the epoch number of the last log delivered before the connection is lost, or nil if no log has
+	// been delivered. The logs after it may be missed while disconnected, backfill them by GetLogs.
+	LastEpoch *big.Int
+	// Err is the error which ended the previous subscription, it is rpc.ErrClientQuit if the connection is closed,
+	// such as re-dialed by Client.Reconnect.
+	Err error
+}
+
+// Subscription represents a subscription whose notifications are processed before delivered,
+// such as the one created by Contract.SubscribeEvent.
+//
+// If the connection to conflux node is lost, the subscription is re-established once the connection recovers,
+// and a Resubscription is delivered by Resubscribed so that the missed logs could be backfilled.
+type Subscription struct {
+	mu           sync.Mutex
+	inner        clientSubscription
+	quit         chan struct{}
+	err          chan error
+	resubscribed chan Resubscription
+	unsubOnce    sync.Once
+}
+
+// newLogSubscription creates a Subscription which passes every log received from logs to forward
+// until the subscription is unsubscribed or forward returns an error.
+//
+// If the subscription ends with error and resubscribe is not nil, resubscribe is called to subscribe logs again
+// into logs, it should keep trying until succeeded or quit is closed.
+//
+// forward should return as soon as quit is closed if it blocks.
+func newLogSubscription(inner clientSubscription, logs <-chan types.Log,
+	forward func(log types.Log, quit <-chan struct{}) error,
+	resubscribe func(quit <-chan struct{}) (clientSubscription, error)) *Subscription {
+
+	sub := &Subscription{
+		inner:        inner,
+		quit:         make(chan struct{}),
+		err:          make(chan error, 1),
+		resubscribed: make(chan Resubscription, 1),
+	}
+
+	go func() {
+		defer close(sub.err)
+
+		var lastEpoch *big.Int
+		for {
+			select {
+			case log := <-logs:
+				if err := forward(log, sub.quit); err != nil {
+					sub.err <- err
+					sub.current().Unsubscribe()
+					return
+				}
+				if log.EpochNumber != nil {
+					lastEpoch = log.EpochNumber.ToInt()
+				}
+			case err, ok := <-sub.current().Err():
+				select {
+				case <-sub.quit:
+					return
+				default:
+				}
+
+				// the subscription ends without error if its connection is closed, which is resubscribed as well
+				if !ok || err == nil {
+					if resubscribe == nil {
+						return
+					}
+					err = rpc.ErrClientQuit
+				}
+
+				if resubscribe == nil {
+					sub.err <- err
+					return
+				}
+
+				inner, resubErr := resubscribe(sub.quit)
+				if resubErr != nil {
+					select {
+					case <-sub.quit:
+					default:
+						if !errors.Is(resubErr, ErrClientShutdown) {
+							sub.err <- resubErr
+						}
+					}
+					return
+				}
+
+				if !sub.replace(inner) {
+					return
+				}
+				sub.notifyResubscribed(Resubscription{LastEpoch: lastEpoch, Err: err})
+			case <-sub.quit:
+				return
+			}
+		}
+	}()
+
+	return sub
+}
+
+func (sub *Subscription) current() clientSubscription {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.inner
+}
+
+// replace replaces the inner subscription, it returns false and unsubscribes inner if already unsubscribed.
+func (sub *Subscription) replace(inner clientSubscription) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	select {
+	case <-sub.quit:
+		inner.Unsubscribe()
+		return false
+	default:
+		sub.inner = inner
+		return true
+	}
+}
+
+// notifyResubscribed delivers r without blocking. If the previous one is not received yet, it is kept
+// instead of r, because its LastEpoch is not later than r's, which is enough for backfilling.
+func (sub *Subscription) notifyResubscribed(r Resubscription) {
+	select {
+	case sub.resubscribed <- r:
+	default:
+	}
+}
+
+// Err returns the subscription error channel. The error channel receives a value if there is
+// an issue with the subscription or delivering notifications, and it is closed when the
+// subscription ends, including after Unsubscribe is called.
+func (sub *Subscription) Err() <-chan error {
+	return sub.err
+}
+
+// Resubscribed returns the channel which receives a value every time the subscription is re-established after
+// the connection is lost, the notifications during the disconnection may be missed.
+func (sub *Subscription) Resubscribed() <-chan Resubscription {
+	return sub.resubscribed
+}
+
+// Unsubscribe unsubscribes the notification and closes the error channel.
+// It can safely be called more than once.
+func (sub *Subscription) Unsubscribe() {
+	sub.unsubOnce.Do(func() {
+		sub.mu.Lock()
+		close(sub.quit)
+		inner := sub.inner
+		sub.mu.Unlock()
+
+		inner.Unsubscribe()
+	})
+}
+
+// newResubscriber returns a function which subscribes by subscribe until succeeded or quit is closed, it reconnects
+// client if supported before retrying with backoff. It gives up if the failure is not caused by connection, or the
+// client is closed, in which case ErrClientShutdown is returned.
+func newResubscriber(client interface{}, subscribe func(ctx context.Context) (clientSubscription, error)) func(quit <-chan struct{}) (clientSubscription, error) {
+	clock := clockOrDefault(nil)
+	if c, ok := client.(*Client); ok {
+		clock = c.getClock()
+	}
+	backoff := &Backoff{Jitter: 0.2}
+
+	return func(quit <-chan struct{}) (clientSubscription, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		for attempt := 0; ; attempt++ {
+			if c, ok := client.(closingReporter); ok && c.isClosing() {
+				return nil, types.WrapError(ErrClientShutdown, "resubscribe error")
+			}
+
+			sub, err := subscribe(ctx)
+			if err == nil {
+				return sub, nil
+			}
+
+			if !IsConnectionError(err) {
+				return nil, types.WrapError(err, "resubscribe error")
+			}
+
+			// the closed connection is never recovered if client could not reconnect
+			r, ok := client.(reconnecter)
+			if !ok && errors.Is(err, rpc.ErrClientQuit) {
+				return nil, types.WrapError(err, "resubscribe error")
+			}
+			if ok {
+				reconnectErr := r.Reconnect()
+				if errors.Is(reconnectErr, ErrClientShutdown) {
+					return nil, types.WrapError(reconnectErr, "resubscribe error")
+				}
+				if errors.Is(reconnectErr, ErrReconnectNotSupported) && errors.Is(err, rpc.ErrClientQuit) {
+					return nil, types.WrapError(err, "resubscribe error")
+				}
+			}
+
+			select {
+			case <-quit:
+				return nil, fmt.Errorf("resubscribe is cancelled after %v attempts", attempt+1)
+			case <-clock.After(backoff.Delay(attempt)):
+			}
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/rpc"
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeSubscription is a clientSubscription whose error is sent by fail.
+type fakeSubscription struct {
+	err          chan error
+	once         sync.Once
+	unsubscribed chan struct{}
+}
+
+func newFakeSubscription() *fakeSubscription {
+	return &fakeSubscription{err: make(chan error, 1), unsubscribed: make(chan struct{})}
+}
+
+func (s *fakeSubscription) Err() <-chan error { return s.err }
+
+func (s *fakeSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.unsubscribed)
+		close(s.err)
+	})
+}
+
+func (s *fakeSubscription) fail(err error) {
+	s.err <- err
+}
+
+// pubSubService is the cfx rpc service which publishes the canned notifications to each subscription of logs
+// or newHeads, it is served in process to test the subscriptions of client end to end.
+type pubSubService struct {
+	logs     []interface{}
+	newHeads []interface{}
+}
+
+func (s *pubSubService) Logs(ctx context.Context, filter json.RawMessage) (*rpc.Subscription, error) {
+	return publish(ctx, s.logs)
+}
+
+func (s *pubSubService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return publish(ctx, s.newHeads)
+}
+
+// publish creates a subscription and notifies it with notifications, which are sent once the subscription is activated.
+func publish(ctx context.Context, notifications []interface{}) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	for _, n := range notifications {
+		if err := notifier.Notify(sub.ID, n); err != nil {
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// newPubSubClient creates a client connected to service in process, the returned function closes them.
+func newPubSubClient(service *pubSubService) (*Client, func()) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("cfx", service); err != nil {
+		panic(err)
+	}
+
+	client, _ := NewClientWithRPCRequester(rpc.DialInProc(server))
+	return client, func() {
+		client.Close()
+		server.Stop()
+	}
+}
+
+func TestSubscriptionResubscribe(t *testing.T) {
+
+	Convey("Subject: Resubscribe after the connection is lost", t, func() {
+		logs := make(chan types.Log)
+		received := make(chan types.Log, 10)
+		forward := func(log types.Log, quit <-chan struct{}) error {
+			received <- log
+			return nil
+		}
+
+		first, second := newFakeSubscription(), newFakeSubscription()
+
+		Convey("Given the subscription is re-established by resubscribe", func() {
+			resubscribe := func(quit <-chan struct{}) (clientSubscription, error) {
+				return second, nil
+			}
+			sub := newLogSubscription(first, logs, forward, resubscribe)
+			defer sub.Unsubscribe()
+
+			logs <- types.Log{EpochNumber: types.NewBigInt(16)}
+			<-received
+			first.fail(io.EOF)
+
+			Convey("Deliver Resubscription with the epoch of the last log", func() {
+				select {
+				case r := <-sub.Resubscribed():
+					So(r.LastEpoch.Int64(), ShouldEqual, 16)
+					So(r.Err, ShouldEqual, io.EOF)
+				case <-time.After(time.Second):
+					t.Fatal("resubscription is not delivered")
+				}
+
+				logs <- types.Log{}
+				select {
+				case <-received:
+				case <-time.After(time.Second):
+					t.Fatal("log is not delivered after resubscribed")
+				}
+			})
+
+			Convey("Unsubscribe the new subscription", func() {
+				<-sub.Resubscribed()
+				sub.Unsubscribe()
+				<-second.unsubscribed
+				_, ok := <-sub.Err()
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("Given resubscribe is not supported", func() {
+			sub := newLogSubscription(first, logs, forward, nil)
+			first.fail(io.EOF)
+
+			Convey("Deliver the error and end", func() {
+				So(<-sub.Err(), ShouldEqual, io.EOF)
+				_, ok := <-sub.Err()
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestSubscriptionResubscribeAfterReconnect(t *testing.T) {
+
+	Convey("Subject: Resubscribe after the connection is re-dialed by Reconnect", t, func() {
+		server := rpc.NewServer()
+		err := server.RegisterName("cfx", &pubSubService{logs: []interface{}{
+			types.Log{EpochNumber: types.NewBigInt(16)},
+		}})
+		So(err, ShouldBeNil)
+		defer server.Stop()
+
+		conn, _ := newRedialingRequester(func() (RPCRequester, error) {
+			return rpc.DialInProc(server), nil
+		})
+		client, _ := NewClientWithRPCRequester(conn)
+		defer client.Close()
+
+		logs := make(chan types.Log, 10)
+		sub, err := client.SubscribeLogsWithReconnect(context.Background(), logs, types.LogFilter{})
+		So(err, ShouldBeNil)
+		defer sub.Unsubscribe()
+		<-logs
+
+		So(client.Reconnect(), ShouldBeNil)
+
+		Convey("Deliver Resubscription and keep receiving logs on the new connection", func() {
+			select {
+			case r := <-sub.Resubscribed():
+				So(r.LastEpoch.Int64(), ShouldEqual, 16)
+				So(r.Err, ShouldEqual, rpc.ErrClientQuit)
+			case err := <-sub.Err():
+				t.Fatalf("subscription ended: %v", err)
+			case <-time.After(time.Second):
+				t.Fatal("resubscription is not delivered")
+			}
+
+			select {
+			case <-logs:
+			case <-time.After(time.Second):
+				t.Fatal("log is not delivered after resubscribed")
+			}
+		})
+	})
+}
+
+func TestResubscriber(t *testing.T) {
+
+	Convey("Subject: Subscribe again until the connection recovers", t, func() {
+		client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+		clock := newFakeClock()
+		client.SetClock(clock)
+		quit := make(chan struct{})
+
+		Convey("Given subscribing fails by connection error twice", func() {
+			attempts := 0
+			resubscribe := newResubscriber(client, func(ctx context.Context) (clientSubscription, error) {
+				attempts++
+				if attempts <= 2 {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return newFakeSubscription(), nil
+			})
+
+			Convey("Return the new subscription after backoff", func() {
+				sub, err := resubscribe(quit)
+				So(err, ShouldBeNil)
+				So(sub, ShouldNotBeNil)
+				So(attempts, ShouldEqual, 3)
+				So(len(clock.slept()), ShouldEqual, 2)
+			})
+		})
+
+		Convey("Given subscribing fails by other error", func() {
+			resubscribe := newResubscriber(client, func(ctx context.Context) (clientSubscription, error) {
+				return nil, errors.New("invalid filter")
+			})
+
+			Convey("Return error without retrying", func() {
+				_, err := resubscribe(quit)
+				So(err, ShouldNotBeNil)
+				So(len(clock.slept()), ShouldEqual, 0)
+			})
+		})
+	})
+}
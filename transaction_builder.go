@@ -0,0 +1,129 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransactionBuilder builds an unsigned transaction by chainable setters, the fields not set are filled by
+// the client when building, see Client.ApplyUnsignedTransactionDefault.
+//
+// The setters record the first invalid argument, which is returned by Build.
+type TransactionBuilder struct {
+	tx  types.UnsignedTransaction
+	err error
+}
+
+// NewTransactionBuilder creates a TransactionBuilder without any field set.
+func NewTransactionBuilder() *TransactionBuilder {
+	return new(TransactionBuilder)
+}
+
+// From sets the sender of transaction, the signer or default account of client is used if not set.
+func (builder *TransactionBuilder) From(from types.Address) *TransactionBuilder {
+	builder.setAddress("from", &builder.tx.From, from)
+	return builder
+}
+
+// To sets the receiver of transaction, the transaction creates contract if not set.
+func (builder *TransactionBuilder) To(to types.Address) *TransactionBuilder {
+	builder.setAddress("to", &builder.tx.To, to)
+	return builder
+}
+
+// Value sets the amount in drip transferred to the receiver.
+func (builder *TransactionBuilder) Value(value *big.Int) *TransactionBuilder {
+	builder.setBig("value", &builder.tx.Value, value)
+	return builder
+}
+
+// Data sets the data of transaction, such as the encoded contract method call or the contract bytecode.
+func (builder *TransactionBuilder) Data(data []byte) *TransactionBuilder {
+	builder.tx.Data = append(hexutil.Bytes(nil), data...)
+	return builder
+}
+
+// GasPrice sets the gas price in drip.
+func (builder *TransactionBuilder) GasPrice(gasPrice *big.Int) *TransactionBuilder {
+	builder.setBig("gasPrice", &builder.tx.GasPrice, gasPrice)
+	return builder
+}
+
+// Gas sets the gas limit.
+func (builder *TransactionBuilder) Gas(gas *big.Int) *TransactionBuilder {
+	builder.setBig("gas", &builder.tx.Gas, gas)
+	return builder
+}
+
+// Nonce sets the nonce, which is useful for replacing a pending transaction or signing offline.
+func (builder *TransactionBuilder) Nonce(nonce *big.Int) *TransactionBuilder {
+	builder.setBig("nonce", &builder.tx.Nonce, nonce)
+	return builder
+}
+
+// StorageLimit sets the storage limit in bytes.
+func (builder *TransactionBuilder) StorageLimit(storageLimit *big.Int) *TransactionBuilder {
+	builder.setBig("storageLimit", &builder.tx.StorageLimit, storageLimit)
+	return builder
+}
+
+// Epoch sets the epoch height of transaction.
+func (builder *TransactionBuilder) Epoch(epochHeight *big.Int) *TransactionBuilder {
+	builder.setBig("epochHeight", &builder.tx.EpochHeight, epochHeight)
+	return builder
+}
+
+// ChainID sets the chain id of transaction.
+func (builder *TransactionBuilder) ChainID(chainID *big.Int) *TransactionBuilder {
+	builder.setBig("chainId", &builder.tx.ChainID, chainID)
+	return builder
+}
+
+// Build validates the fields set and applies defaults for the others by client, and returns a new transaction
+// which is ready to sign. The builder could be reused to build more transactions.
+//
+// The nonce is fetched from node if not set, and never allocated by the nonce manager of client because the
+// transaction may be never sent, so the transactions built without nonce get the same one until one of them is
+// executed. Set the nonce explicitly, or send a transaction whose nonce is not set by Client.SendTransaction to
+// allocate it by the nonce manager.
+func (builder *TransactionBuilder) Build(client ClientOperator) (*types.UnsignedTransaction, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	if client == nil {
+		return nil, errors.New("client is necessary for applying default fields of transaction")
+	}
+
+	tx := builder.tx
+	tx.Data = append(hexutil.Bytes(nil), builder.tx.Data...)
+	if err := client.ApplyUnsignedTransactionDefault(&tx); err != nil {
+		msg := fmt.Sprintf("apply default field of transaction {%+v} error", tx)
+		return nil, types.WrapError(err, msg)
+	}
+
+	return &tx, nil
+}
+
+func (builder *TransactionBuilder) setAddress(name string, field **types.Address, address types.Address) {
+	if err := address.ValidateChecksum(); err != nil && builder.err == nil {
+		msg := fmt.Sprintf("%v of transaction is invalid", name)
+		builder.err = types.WrapError(err, msg)
+	}
+	*field = &address
+}
+
+func (builder *TransactionBuilder) setBig(name string, field **hexutil.Big, value *big.Int) {
+	if value != nil && value.Sign() < 0 && builder.err == nil {
+		builder.err = fmt.Errorf("%v of transaction should not be negative, got %v", name, value)
+	}
+	*field = types.ToHexBig(value)
+}
@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/sdktest"
+	"github.com/Conflux-Chain/go-conflux-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTransactionBuilder(t *testing.T) {
+
+	Convey("Subject: Build unsigned transaction by TransactionBuilder", t, func() {
+		from := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+		to := types.Address("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+		Convey("Given all fields are set", func() {
+			requester := sdktest.NewMockRequester()
+			client, _ := NewClientWithRPCRequester(requester)
+			builder := NewTransactionBuilder().From(from).To(to).Value(big.NewInt(10)).Data([]byte{1, 2}).
+				GasPrice(big.NewInt(1)).Gas(big.NewInt(21000)).Nonce(big.NewInt(7)).StorageLimit(big.NewInt(0)).
+				Epoch(big.NewInt(100)).ChainID(big.NewInt(1029))
+
+			Convey("When build it", func() {
+				tx, err := builder.Build(client)
+
+				Convey("Return the transaction without requesting node", func() {
+					So(err, ShouldBeNil)
+					So(*tx.To, ShouldEqual, to)
+					So(tx.Value.ToInt().Int64(), ShouldEqual, 10)
+					So(tx.Nonce.ToInt().Int64(), ShouldEqual, 7)
+					So(tx.EpochHeight.ToInt().Int64(), ShouldEqual, 100)
+					So([]byte(tx.Data), ShouldResemble, []byte{1, 2})
+					So(len(requester.Calls()), ShouldEqual, 0)
+				})
+
+				Convey("Build again returns an independent transaction", func() {
+					another, err := builder.Build(client)
+					So(err, ShouldBeNil)
+					So(another, ShouldNotPointTo, tx)
+					another.Data[0] = 9
+					So(tx.Data[0], ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey("Given the nonce is not set", func() {
+			requester := sdktest.NewMockRequester()
+			requester.OnAny("cfx_getNextNonce").ReturnFunc(func(args ...interface{}) (interface{}, error) {
+				return hexutil.EncodeUint64(5), nil
+			})
+			client, _ := NewClientWithRPCRequester(requester)
+
+			Convey("Apply the nonce fetched from node", func() {
+				tx, err := NewTransactionBuilder().From(from).To(to).GasPrice(big.NewInt(1)).Gas(big.NewInt(21000)).
+					StorageLimit(big.NewInt(0)).Epoch(big.NewInt(100)).ChainID(big.NewInt(1029)).Build(client)
+				So(err, ShouldBeNil)
+				So(tx.Nonce.ToInt().Int64(), ShouldEqual, 5)
+			})
+
+			Convey("Never allocate the nonce by nonce manager even if built many times", func() {
+				manager := NewNonceManager(0)
+				client.SetNonceManager(manager)
+				builder := NewTransactionBuilder().From(from).To(to).GasPrice(big.NewInt(1)).Gas(big.NewInt(21000)).
+					StorageLimit(big.NewInt(0)).Epoch(big.NewInt(100)).ChainID(big.NewInt(1029))
+				for i := 0; i < 3; i++ {
+					tx, err := builder.Build(client)
+					So(err, ShouldBeNil)
+					So(tx.Nonce.ToInt().Int64(), ShouldEqual, 5)
+				}
+
+				nonce, err := manager.Next(client, from)
+				So(err, ShouldBeNil)
+				So(nonce.Int64(), ShouldEqual, 5)
+			})
+		})
+
+		Convey("Given invalid fields", func() {
+			client, _ := NewClientWithRPCRequester(sdktest.NewMockRequester())
+
+			Convey("Return error for negative value", func() {
+				_, err := NewTransactionBuilder().From(from).To(to).Value(big.NewInt(-1)).Build(client)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Return error for receiver with wrong checksum", func() {
+				_, err := NewTransactionBuilder().From(from).To(types.Address("0x1CAD0b19bb29d4674531d6f115237e16afce377d")).Build(client)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Return error without client", func() {
+				_, err := NewTransactionBuilder().From(from).To(to).Build(nil)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
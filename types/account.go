@@ -0,0 +1,11 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// AccountPendingInfo represents the pending transactions of an account in the transaction pool of conflux node
+type AccountPendingInfo struct {
+	LocalNonce    *hexutil.Big `json:"localNonce"`
+	PendingCount  *hexutil.Big `json:"pendingCount"`
+	PendingNonce  *hexutil.Big `json:"pendingNonce"`
+	NextPendingTx Hash         `json:"nextPendingTx"`
+}
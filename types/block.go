@@ -4,7 +4,11 @@
 
 package types
 
-import "github.com/ethereum/go-ethereum/common/hexutil"
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
 
 // BlockHeader represents a block header in Conflux.
 type BlockHeader struct {
@@ -26,6 +30,34 @@ type BlockHeader struct {
 	Adaptive              bool            `json:"adaptive"`
 	Nonce                 *hexutil.Big    `json:"nonce"`
 	Size                  *hexutil.Big    `json:"size,omitempty"`
+
+	// Extra holds the fields responded by conflux node but not supported by this SDK yet, such as the fields
+	// added by a newer node, it is nil if there is no such field.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// blockHeaderJSON has the same fields as BlockHeader without the JSON unmarshaler.
+type blockHeaderJSON BlockHeader
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the unknown fields are kept in Extra.
+func (header *BlockHeader) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var decoded blockHeaderJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	extra, err := unknownJSONFields(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	*header = BlockHeader(decoded)
+	header.Extra = extra
+	return nil
 }
 
 // BlockSummary includes block header and a list of transaction hashes
@@ -34,9 +66,59 @@ type BlockSummary struct {
 	Transactions []Hash `json:"transactions"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface, the unknown fields are kept in Extra.
+func (summary *BlockSummary) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var decoded struct {
+		blockHeaderJSON
+		Transactions []Hash `json:"transactions"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	extra, err := unknownJSONFields(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	summary.BlockHeader = BlockHeader(decoded.blockHeaderJSON)
+	summary.Extra = extra
+	summary.Transactions = decoded.Transactions
+	return nil
+}
+
 // Block represents a block in Conflux, including block header
 // and a list of detailed transactions.
 type Block struct {
 	BlockHeader
 	Transactions []Transaction `json:"transactions"`
 }
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the unknown fields are kept in Extra.
+func (block *Block) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var decoded struct {
+		blockHeaderJSON
+		Transactions []Transaction `json:"transactions"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	extra, err := unknownJSONFields(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	block.BlockHeader = BlockHeader(decoded.blockHeaderJSON)
+	block.Extra = extra
+	block.Transactions = decoded.Transactions
+	return nil
+}
@@ -5,6 +5,7 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,11 +13,22 @@ import (
 
 // Const epoch definitions
 var (
-	EpochEarliest    *Epoch = &Epoch{"earliest", nil}
-	EpochLatestState *Epoch = &Epoch{"latest_state", nil}
-	EpochLatestMined *Epoch = &Epoch{"latest_mined", nil}
+	EpochEarliest         *Epoch = &Epoch{"earliest", nil}
+	EpochLatestCheckpoint *Epoch = &Epoch{"latest_checkpoint", nil}
+	EpochLatestConfirmed  *Epoch = &Epoch{"latest_confirmed", nil}
+	EpochLatestState      *Epoch = &Epoch{"latest_state", nil}
+	EpochLatestMined      *Epoch = &Epoch{"latest_mined", nil}
 )
 
+// epochTags are the named epochs, keyed by their names
+var epochTags = map[string]*Epoch{
+	EpochEarliest.name:         EpochEarliest,
+	EpochLatestCheckpoint.name: EpochLatestCheckpoint,
+	EpochLatestConfirmed.name:  EpochLatestConfirmed,
+	EpochLatestState.name:      EpochLatestState,
+	EpochLatestMined.name:      EpochLatestMined,
+}
+
 // Epoch represents an epoch in Conflux.
 type Epoch struct {
 	name   string
@@ -28,11 +40,78 @@ func NewEpochNumber(number *big.Int) *Epoch {
 	return &Epoch{"", number}
 }
 
+// NewEpochNumberUint64 creates an instance of Epoch with specified uint64 number.
+func NewEpochNumberUint64(number uint64) *Epoch {
+	return &Epoch{"", new(big.Int).SetUint64(number)}
+}
+
 // NewEpochWithBlockHash creates an instance of Epoch with specified block hash.
 func NewEpochWithBlockHash(blockHash Hash) *Epoch {
 	return &Epoch{string(blockHash), nil}
 }
 
+// ToInt returns the epoch number if epoch is created by NewEpochNumber, otherwise returns false.
+func (e *Epoch) ToInt() (result *big.Int, isSuccess bool) {
+	if e.number != nil {
+		return new(big.Int).Set(e.number), true
+	}
+	return nil, false
+}
+
+// NextN returns the epoch n epochs after e, it returns error if e is not an epoch number.
+func (e *Epoch) NextN(n uint64) (*Epoch, error) {
+	if e == nil || e.number == nil {
+		return nil, fmt.Errorf("epoch %v is not an epoch number", e)
+	}
+	return NewEpochNumber(new(big.Int).Add(e.number, new(big.Int).SetUint64(n))), nil
+}
+
+// EpochIterator iterates the epoch numbers of a bounded range in ascending order.
+type EpochIterator struct {
+	next *big.Int
+	to   *big.Int
+}
+
+// EpochRange returns an iterator of the epoch numbers from from to to inclusively, which is empty if from is
+// greater than to. It returns error if from or to is not an epoch number.
+func EpochRange(from, to *Epoch) (*EpochIterator, error) {
+	if from == nil || from.number == nil || to == nil || to.number == nil {
+		return nil, fmt.Errorf("epoch range [%v, %v] should be epoch numbers", from, to)
+	}
+	return &EpochIterator{new(big.Int).Set(from.number), new(big.Int).Set(to.number)}, nil
+}
+
+// Len returns the number of epochs not iterated yet.
+func (it *EpochIterator) Len() uint64 {
+	if it.next.Cmp(it.to) > 0 {
+		return 0
+	}
+	return new(big.Int).Sub(it.to, it.next).Uint64() + 1
+}
+
+// Next returns the next epoch number, or false if all epochs are iterated.
+func (it *EpochIterator) Next() (*Epoch, bool) {
+	from, _, ok := it.NextN(1)
+	return from, ok
+}
+
+// NextN returns the first and last epoch numbers of the next n epochs, which are fewer than n at the end of the
+// range, or false if all epochs are iterated.
+func (it *EpochIterator) NextN(n uint64) (from, to *Epoch, ok bool) {
+	if n == 0 || it.next.Cmp(it.to) > 0 {
+		return nil, nil, false
+	}
+
+	last := new(big.Int).Add(it.next, new(big.Int).SetUint64(n-1))
+	if last.Cmp(it.to) > 0 {
+		last.Set(it.to)
+	}
+
+	from, to = NewEpochNumber(it.next), NewEpochNumber(new(big.Int).Set(last))
+	it.next = last.Add(last, big.NewInt(1))
+	return from, to, true
+}
+
 // String implements the fmt.Stringer interface
 func (e *Epoch) String() string {
 	if len(e.name) > 0 {
@@ -46,3 +125,46 @@ func (e *Epoch) String() string {
 func (e *Epoch) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Epoch) UnmarshalText(text []byte) error {
+	parsed, err := ParseEpoch(string(text))
+	if err != nil {
+		return err
+	}
+	*e = *parsed
+	return nil
+}
+
+// Equals reports whether e and target represent the same epoch, that is the same tag, number or block hash.
+func (e *Epoch) Equals(target *Epoch) bool {
+	if e == nil || target == nil {
+		return e == target
+	}
+
+	if e.number != nil || target.number != nil {
+		return e.number != nil && target.number != nil && e.number.Cmp(target.number) == 0
+	}
+	return e.name == target.name
+}
+
+// ParseEpoch parses the epoch tag such as "latest_state", the hex epoch number such as "0x10",
+// or the hex block hash to Epoch.
+func ParseEpoch(epoch string) (*Epoch, error) {
+	if tag, ok := epochTags[epoch]; ok {
+		return tag, nil
+	}
+
+	if len(epoch) == 66 {
+		if _, err := hexutil.Decode(epoch); err != nil {
+			return nil, fmt.Errorf("invalid epoch block hash %v: %v", epoch, err)
+		}
+		return NewEpochWithBlockHash(Hash(epoch)), nil
+	}
+
+	number, err := hexutil.DecodeBig(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch %v, it should be a tag, hex number or block hash: %v", epoch, err)
+	}
+	return NewEpochNumber(number), nil
+}
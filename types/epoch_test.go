@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestEpochMarshal(t *testing.T) {
+	table := []struct {
+		epoch  *Epoch
+		expect string
+	}{
+		{EpochEarliest, `"earliest"`},
+		{EpochLatestCheckpoint, `"latest_checkpoint"`},
+		{EpochLatestConfirmed, `"latest_confirmed"`},
+		{EpochLatestState, `"latest_state"`},
+		{EpochLatestMined, `"latest_mined"`},
+		{NewEpochNumber(big.NewInt(16)), `"0x10"`},
+	}
+
+	for _, v := range table {
+		encoded, err := json.Marshal(v.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(encoded) != v.expect {
+			t.Errorf("Test marshal %v failed, expect %v, actual %s", v.epoch, v.expect, encoded)
+		}
+
+		var decoded Epoch
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equals(v.epoch) {
+			t.Errorf("Test unmarshal %s failed, actual %v", encoded, &decoded)
+		}
+	}
+}
+
+func TestParseEpoch(t *testing.T) {
+	blockHash := "0xbb1eea3c8a574dc19f7d8311a2096e23a39f12e649a20766544f2df67aac0bed"
+	table := []struct {
+		input  string
+		expect *Epoch
+	}{
+		{"latest_confirmed", EpochLatestConfirmed},
+		{"earliest", EpochEarliest},
+		{"0x0", NewEpochNumber(big.NewInt(0))},
+		{"0x1f", NewEpochNumber(big.NewInt(31))},
+		{blockHash, NewEpochWithBlockHash(Hash(blockHash))},
+	}
+	for _, v := range table {
+		actual, err := ParseEpoch(v.input)
+		if err != nil {
+			t.Errorf("Test ParseEpoch %v failed, error: %v", v.input, err)
+			continue
+		}
+		if !actual.Equals(v.expect) {
+			t.Errorf("Test ParseEpoch %v failed, expect %v, actual %v", v.input, v.expect, actual)
+		}
+	}
+
+	for _, v := range []string{"", "latest", "16", "0x", "0xzz"} {
+		if actual, err := ParseEpoch(v); err == nil {
+			t.Errorf("Test ParseEpoch %q failed, expect error, actual %v", v, actual)
+		}
+	}
+}
+
+func TestEpochEquals(t *testing.T) {
+	if !NewEpochNumber(big.NewInt(1)).Equals(NewEpochNumber(big.NewInt(1))) {
+		t.Error("Test Equals of same number failed")
+	}
+	if NewEpochNumber(big.NewInt(1)).Equals(NewEpochNumber(big.NewInt(2))) {
+		t.Error("Test Equals of different numbers failed")
+	}
+	if EpochLatestState.Equals(EpochLatestMined) {
+		t.Error("Test Equals of different tags failed")
+	}
+	if EpochLatestState.Equals(nil) {
+		t.Error("Test Equals with nil failed")
+	}
+}
+
+func TestEpochNextN(t *testing.T) {
+	epoch := NewEpochNumberUint64(10)
+
+	if next, err := epoch.NextN(5); err != nil || !next.Equals(NewEpochNumberUint64(15)) {
+		t.Errorf("expect epoch 15, got %v, %v", next, err)
+	}
+	if _, err := EpochLatestState.NextN(1); err == nil {
+		t.Error("expect error for epoch tag")
+	}
+	if n, _ := epoch.ToInt(); n.Uint64() != 10 {
+		t.Errorf("expect epoch not modified, got %v", n)
+	}
+}
+
+func TestEpochRange(t *testing.T) {
+	it, err := EpochRange(NewEpochNumberUint64(3), NewEpochNumberUint64(6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if it.Len() != 4 {
+		t.Fatalf("expect 4 epochs, got %v", it.Len())
+	}
+	for i := uint64(3); i <= 6; i++ {
+		if epoch, ok := it.Next(); !ok || !epoch.Equals(NewEpochNumberUint64(i)) {
+			t.Errorf("expect epoch %v, got %v, %v", i, epoch, ok)
+		}
+	}
+	if epoch, ok := it.Next(); ok || it.Len() != 0 {
+		t.Errorf("expect range exhausted, got %v", epoch)
+	}
+
+	if it, err := EpochRange(NewEpochNumberUint64(7), NewEpochNumberUint64(6)); err != nil || it.Len() != 0 {
+		t.Errorf("expect empty range for reversed epochs, got %v", err)
+	}
+	if _, err := EpochRange(EpochEarliest, NewEpochNumberUint64(6)); err == nil {
+		t.Error("expect error for epoch tag")
+	}
+}
+
+func TestEpochRangeNextN(t *testing.T) {
+	it, err := EpochRange(NewEpochNumberUint64(0), NewEpochNumberUint64(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expects := [][2]uint64{{0, 3}, {4, 7}, {8, 9}}
+	for _, expect := range expects {
+		from, to, ok := it.NextN(4)
+		if !ok || !from.Equals(NewEpochNumberUint64(expect[0])) || !to.Equals(NewEpochNumberUint64(expect[1])) {
+			t.Errorf("expect window %v, got [%v, %v], %v", expect, from, to, ok)
+		}
+	}
+	if _, _, ok := it.NextN(4); ok {
+		t.Error("expect range exhausted")
+	}
+}
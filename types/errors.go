@@ -1,6 +1,12 @@
 package types
 
-import "fmt"
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
 
 // AccountNotFoundError represents error of account not found.
 type AccountNotFoundError struct {
@@ -18,3 +24,183 @@ func NewAccountNotFoundError(address Address) *AccountNotFoundError {
 func (e *AccountNotFoundError) Error() string {
 	return fmt.Sprintf("Not found account %v", e.Account)
 }
+
+// ReorgError represents error of the transaction disappears after it has been packed in a block, because the block
+// is reverted by a pivot chain switch and the transaction is not kept in the transaction pool.
+type ReorgError struct {
+	TxHash    Hash
+	BlockHash Hash
+}
+
+// NewReorgError creates a new ReorgError instance
+func NewReorgError(txHash, blockHash Hash) *ReorgError {
+	return &ReorgError{
+		TxHash:    txHash,
+		BlockHash: blockHash,
+	}
+}
+
+// Error implements error interface
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("Transaction %v disappeared after packed in block %v, which is reverted", e.TxHash, e.BlockHash)
+}
+
+// InsufficientBalanceError represents error of the balance is not enough to pay for a transaction.
+type InsufficientBalanceError struct {
+	Account  Address
+	Balance  *big.Int
+	Required *big.Int
+}
+
+// NewInsufficientBalanceError creates a new InsufficientBalanceError instance
+func NewInsufficientBalanceError(address Address, balance, required *big.Int) *InsufficientBalanceError {
+	return &InsufficientBalanceError{
+		Account:  address,
+		Balance:  balance,
+		Required: required,
+	}
+}
+
+// Shortfall returns the amount of balance lacked
+func (e *InsufficientBalanceError) Shortfall() *big.Int {
+	return new(big.Int).Sub(e.Required, e.Balance)
+}
+
+// Error implements error interface
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("Insufficient balance of account %v, balance %v, required %v, shortfall %v",
+		e.Account, e.Balance, e.Required, e.Shortfall())
+}
+
+// BatchElemErrors represents errors of failed elements in a batch request, keyed by the index of element.
+type BatchElemErrors map[int]error
+
+// Error implements error interface
+func (e BatchElemErrors) Error() string {
+	indexes := make([]int, 0, len(e))
+	for i := range e {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	msgs := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		msgs = append(msgs, fmt.Sprintf("element %v: %v", i, e[i]))
+	}
+	return fmt.Sprintf("%v of batch elements failed: %v", len(e), strings.Join(msgs, "; "))
+}
+
+// revertSelector is the selector of solidity Error(string), which is used to encode revert reason.
+const revertSelector = "08c379a0"
+
+// panicSelector is the selector of solidity Panic(uint256), which is used to encode the panic code
+// of failed assertion, arithmetic overflow and so on.
+const panicSelector = "4e487b71"
+
+// panicReasons are the descriptions of solidity panic codes.
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// RPCError represents a JSON-RPC error responded by conflux node.
+type RPCError struct {
+	Code    int
+	Message string
+	Data    interface{}
+}
+
+// Error implements error interface
+func (e *RPCError) Error() string {
+	if e.Data == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%v, Data: %v", e.Message, e.Data)
+}
+
+// ErrorCode returns the JSON-RPC error code
+func (e *RPCError) ErrorCode() int {
+	return e.Code
+}
+
+// RevertReason returns the solidity revert reason decoded from the error data,
+// and false if the error data contains no revert reason.
+func (e *RPCError) RevertReason() (string, bool) {
+	data, ok := e.Data.(string)
+	if !ok {
+		return "", false
+	}
+	return DecodeRevertReason(data)
+}
+
+// DecodeRevertReason decodes the solidity revert reason encoded as Error(string) or Panic(uint256) from data,
+// the data could be a hex string or a message contains the hex encoded revert reason.
+// The panic code is decoded as a readable description, such as "panic: arithmetic underflow or overflow (0x11)".
+func DecodeRevertReason(data string) (string, bool) {
+	if bytes, ok := encodedAfterSelector(data, revertSelector); ok {
+		return decodeErrorString(bytes)
+	}
+
+	if bytes, ok := encodedAfterSelector(data, panicSelector); ok && len(bytes) >= 32 {
+		code := new(big.Int).SetBytes(bytes[:32])
+		if code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				return fmt.Sprintf("panic: %v (0x%x)", reason, code), true
+			}
+		}
+		return fmt.Sprintf("panic: unknown code 0x%x", code), true
+	}
+
+	return "", false
+}
+
+// encodedAfterSelector returns the bytes of hex encoded data following the selector in data.
+func encodedAfterSelector(data string, selector string) ([]byte, bool) {
+	index := strings.Index(data, "0x"+selector)
+	if index < 0 {
+		return nil, false
+	}
+
+	encoded := data[index+2+len(selector):]
+	if end := strings.IndexFunc(encoded, func(r rune) bool { return !isHexChar(r) }); end >= 0 {
+		encoded = encoded[:end]
+	}
+
+	bytes, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+	return bytes, true
+}
+
+// decodeErrorString decodes the ABI encoded string argument of Error(string).
+func decodeErrorString(bytes []byte) (string, bool) {
+	if len(bytes) < 64 {
+		return "", false
+	}
+
+	offset := new(big.Int).SetBytes(bytes[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(bytes))-32 {
+		return "", false
+	}
+	start := offset.Uint64() + 32
+
+	length := new(big.Int).SetBytes(bytes[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(bytes))-start {
+		return "", false
+	}
+
+	return string(bytes[start : start+length.Uint64()]), true
+}
+
+func isHexChar(r rune) bool {
+	return ('0' <= r && r <= '9') || ('a' <= r && r <= 'f') || ('A' <= r && r <= 'F')
+}
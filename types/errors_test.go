@@ -0,0 +1,45 @@
+package types
+
+import "testing"
+
+const insufficientBalanceRevert = "0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000014696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+func TestDecodeRevertReason(t *testing.T) {
+	table := []struct {
+		data   string
+		reason string
+		ok     bool
+	}{
+		{insufficientBalanceRevert, "insufficient balance", true},
+		{"Reverted " + insufficientBalanceRevert, "insufficient balance", true},
+		{"VmError(Reverted)", "", false},
+		{"0x08c379a00000", "", false},
+		{"0x", "", false},
+		{"0x4e487b710000000000000000000000000000000000000000000000000000000000000011", "panic: arithmetic underflow or overflow (0x11)", true},
+		{"Reverted 0x4e487b710000000000000000000000000000000000000000000000000000000000000001", "panic: assert(false) (0x1)", true},
+		{"0x4e487b7100000000000000000000000000000000000000000000000000000000000000ff", "panic: unknown code 0xff", true},
+		{"0x4e487b710011", "", false},
+	}
+
+	for _, v := range table {
+		reason, ok := DecodeRevertReason(v.data)
+		if ok != v.ok || reason != v.reason {
+			t.Errorf("expect decode %v to (%v, %v), actual (%v, %v)", v.data, v.reason, v.ok, reason, ok)
+		}
+	}
+}
+
+func TestRPCErrorRevertReason(t *testing.T) {
+	err := &RPCError{Code: -32015, Message: "Transaction reverted", Data: insufficientBalanceRevert}
+	if reason, ok := err.RevertReason(); !ok || reason != "insufficient balance" {
+		t.Errorf("expect revert reason insufficient balance, actual %v", reason)
+	}
+
+	err = &RPCError{Code: -32000, Message: "nonce too low"}
+	if _, ok := err.RevertReason(); ok {
+		t.Errorf("expect no revert reason of %v", err)
+	}
+	if err.Error() != "nonce too low" {
+		t.Errorf("expect error message nonce too low, actual %v", err.Error())
+	}
+}
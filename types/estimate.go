@@ -4,10 +4,25 @@
 
 package types
 
-import "github.com/ethereum/go-ethereum/common/hexutil"
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
 
 //Estimate represents estimated gas will be used and storage will be collateralized when transaction excutes
 type Estimate struct {
 	GasUsed               *hexutil.Big `json:"gasUsed"`
 	StorageCollateralized *hexutil.Big `json:"storageCollateralized"`
 }
+
+// TransactionCost represents the breakdown of the max cost in Drip of a transaction,
+// Total = GasFee + StorageCollateral + Value
+type TransactionCost struct {
+	// GasFee is gas * gasPrice
+	GasFee *big.Int
+	// StorageCollateral is storageLimit * collateral per byte
+	StorageCollateral *big.Int
+	Value             *big.Int
+	Total             *big.Int
+}
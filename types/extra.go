@@ -0,0 +1,64 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// unknownJSONFields returns the fields of JSON object data which are not decoded into the struct pointed by v,
+// such as the fields added by a newer conflux node. It returns nil if there is no unknown field.
+func unknownJSONFields(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	collectJSONFieldNames(reflect.TypeOf(v).Elem(), known)
+	for name := range fields {
+		if known[name] {
+			delete(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// collectJSONFieldNames adds the JSON names of fields of struct type t to names, including the fields of embedded structs.
+func collectJSONFieldNames(t reflect.Type, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				collectJSONFieldNames(fieldType, names)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalUnknownFields(t *testing.T) {
+	txJSON := `{"hash":"0xa1","nonce":"0x1","from":"0x1cad0b19bb29d4674531d6f115237e16afce377c","data":"0x","newTxField":"0x10"}`
+	blockJSON := `{"hash":"0xb1","epochNumber":"0x10","newBlockField":{"a":1},"transactions":[` + txJSON + `]}`
+
+	var block Block
+	if err := json.Unmarshal([]byte(blockJSON), &block); err != nil {
+		t.Fatal(err)
+	}
+	if block.Hash != "0xb1" || block.EpochNumber.ToInt().Int64() != 16 {
+		t.Errorf("expect known fields decoded, actual %+v", block.BlockHeader)
+	}
+	if len(block.Extra) != 1 || string(block.Extra["newBlockField"]) != `{"a":1}` {
+		t.Errorf("expect extra field newBlockField of block, actual %v", block.Extra)
+	}
+	if len(block.Transactions) != 1 || block.Transactions[0].Nonce.ToInt().Int64() != 1 {
+		t.Fatalf("expect transactions decoded, actual %+v", block.Transactions)
+	}
+	if len(block.Transactions[0].Extra) != 1 || string(block.Transactions[0].Extra["newTxField"]) != `"0x10"` {
+		t.Errorf("expect extra field newTxField of transaction, actual %v", block.Transactions[0].Extra)
+	}
+
+	var summary BlockSummary
+	if err := json.Unmarshal([]byte(`{"hash":"0xb1","newBlockField":true,"transactions":["0xa1"]}`), &summary); err != nil {
+		t.Fatal(err)
+	}
+	if summary.Hash != "0xb1" || len(summary.Transactions) != 1 || string(summary.Extra["newBlockField"]) != "true" {
+		t.Errorf("expect summary with extra field decoded, actual %+v", summary)
+	}
+
+	var tx Transaction
+	if err := json.Unmarshal([]byte(`{"hash":"0xa1","data":"0x"}`), &tx); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Extra != nil {
+		t.Errorf("expect nil extra without unknown fields, actual %v", tx.Extra)
+	}
+
+	var missing *Transaction
+	if err := json.Unmarshal([]byte(`null`), &missing); err != nil || missing != nil {
+		t.Errorf("expect nil transaction for null, actual %v, error %v", missing, err)
+	}
+}
@@ -4,7 +4,15 @@
 
 package types
 
-import "github.com/ethereum/go-ethereum/common/hexutil"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// maxLogFilterTopics is the max number of topic positions a log filter could specify
+const maxLogFilterTopics = 4
 
 // LogFilter represents the filter of event in a smart contract.
 type LogFilter struct {
@@ -16,6 +24,148 @@ type LogFilter struct {
 	Limit       *uint8    `json:"limit,omitempty"`
 }
 
+// NewLogFilter creates a log filter of the epoch range, nil epoch means the default of node.
+func NewLogFilter(fromEpoch, toEpoch *Epoch) *LogFilter {
+	return &LogFilter{FromEpoch: fromEpoch, ToEpoch: toEpoch}
+}
+
+// AddressAny sets the contract addresses, a log matches if it is emitted by any one of addresses,
+// so that logs of several contracts could be queried in one call.
+//
+// It returns the filter itself for chaining.
+func (filter *LogFilter) AddressAny(addresses ...Address) *LogFilter {
+	filter.Address = append([]Address{}, addresses...)
+	return filter
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, it accepts both a single address and a list of addresses
+// for address, and both a single topic and a list of topics for each topic position.
+func (filter *LogFilter) UnmarshalJSON(data []byte) error {
+	type logFilter LogFilter
+	aux := struct {
+		*logFilter
+		Address json.RawMessage   `json:"address,omitempty"`
+		Topics  []json.RawMessage `json:"topics,omitempty"`
+	}{logFilter: (*logFilter)(filter)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	filter.Address = nil
+	if len(aux.Address) > 0 && string(aux.Address) != "null" {
+		var address Address
+		if err := json.Unmarshal(aux.Address, &address); err == nil {
+			filter.Address = []Address{address}
+		} else if err := json.Unmarshal(aux.Address, &filter.Address); err != nil {
+			return fmt.Errorf("address should be an address or a list of addresses: %v", err)
+		}
+	}
+
+	filter.Topics = nil
+	for i, raw := range aux.Topics {
+		var topics []Hash
+		if string(raw) != "null" {
+			var topic Hash
+			if err := json.Unmarshal(raw, &topic); err == nil {
+				topics = []Hash{topic}
+			} else if err := json.Unmarshal(raw, &topics); err != nil {
+				return fmt.Errorf("topic at position %v should be a topic or a list of topics: %v", i, err)
+			}
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+	return nil
+}
+
+// TopicAny sets the accepted values of the topic at specified position, a log matches the position
+// if its topic equals to any one of hashes. Empty hashes means any topic is accepted at the position.
+//
+// It returns the filter itself for chaining.
+func (filter *LogFilter) TopicAny(position int, hashes ...Hash) *LogFilter {
+	for len(filter.Topics) <= position {
+		filter.Topics = append(filter.Topics, nil)
+	}
+
+	if len(hashes) == 0 {
+		filter.Topics[position] = nil
+	} else {
+		filter.Topics[position] = append([]Hash{}, hashes...)
+	}
+	return filter
+}
+
+// Topic0Any sets the accepted values of the first topic, which is the event signature hash
+// for non-anonymous events, so that logs of several event types could be matched in one query.
+func (filter *LogFilter) Topic0Any(hashes ...Hash) *LogFilter {
+	return filter.TopicAny(0, hashes...)
+}
+
+// Normalize fills the default values of filter and validates it. The ToEpoch is set to EpochLatestState
+// if neither ToEpoch nor BlockHashes is specified.
+func (filter *LogFilter) Normalize() error {
+	if filter.ToEpoch == nil && len(filter.BlockHashes) == 0 {
+		filter.ToEpoch = EpochLatestState
+	}
+	return filter.Validate()
+}
+
+// Validate checks that the epoch range is in order, no more than 4 topic positions are specified,
+// and the addresses and hashes are well-formed.
+func (filter *LogFilter) Validate() error {
+	if filter.FromEpoch != nil && filter.ToEpoch != nil {
+		from, isFromNumber := filter.FromEpoch.ToInt()
+		to, isToNumber := filter.ToEpoch.ToInt()
+		if isFromNumber && isToNumber && from.Cmp(to) > 0 {
+			return fmt.Errorf("fromEpoch %v is greater than toEpoch %v", filter.FromEpoch, filter.ToEpoch)
+		}
+		// no epoch is earlier than the earliest epoch
+		isFromEarliest := filter.FromEpoch.Equals(EpochEarliest)
+		isToEarliest := filter.ToEpoch.Equals(EpochEarliest)
+		if isToEarliest && !isFromEarliest && !(isFromNumber && from.Sign() == 0) {
+			return fmt.Errorf("fromEpoch %v is greater than toEpoch %v", filter.FromEpoch, filter.ToEpoch)
+		}
+	}
+
+	if len(filter.Topics) > maxLogFilterTopics {
+		return fmt.Errorf("at most %v topics are allowed, got %v", maxLogFilterTopics, len(filter.Topics))
+	}
+
+	for _, address := range filter.Address {
+		if !isHexString(string(address), 20) {
+			return fmt.Errorf("invalid address %v", address)
+		}
+	}
+
+	for _, hash := range filter.BlockHashes {
+		if !isHexString(string(hash), 32) {
+			return fmt.Errorf("invalid block hash %v", hash)
+		}
+	}
+
+	for i, topics := range filter.Topics {
+		for _, topic := range topics {
+			if !isHexString(string(topic), 32) {
+				return fmt.Errorf("invalid topic %v at position %v", topic, i)
+			}
+		}
+	}
+	return nil
+}
+
+// isHexString reports whether s is a 0x prefixed hex string of byteLen bytes.
+func isHexString(s string, byteLen int) bool {
+	if len(s) != 2+byteLen*2 || (s[:2] != "0x" && s[:2] != "0X") {
+		return false
+	}
+	for _, c := range s[2:] {
+		if !isHexChar(c) {
+			return false
+		}
+	}
+	return true
+}
+
 // LogEntry represents a summary of event in a smart contract.
 type LogEntry struct {
 	Address Address `json:"address"`
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestLogFilterTopicAnyMarshal(t *testing.T) {
+	transfer := Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approval := Hash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	owner := Hash("0x0000000000000000000000001cad0b19bb29d4674531d6f115237e16afce377c")
+
+	table := []struct {
+		filter *LogFilter
+		expect string
+	}{
+		{
+			filter: new(LogFilter).Topic0Any(transfer, approval),
+			expect: `{"topics":[["` + string(transfer) + `","` + string(approval) + `"]]}`,
+		},
+		{
+			filter: new(LogFilter).Topic0Any(transfer).TopicAny(2, owner),
+			expect: `{"topics":[["` + string(transfer) + `"],null,["` + string(owner) + `"]]}`,
+		},
+		{
+			filter: new(LogFilter).Topic0Any(transfer).Topic0Any(),
+			expect: `{"topics":[null]}`,
+		},
+	}
+
+	for _, v := range table {
+		actual, err := json.Marshal(v.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != v.expect {
+			t.Errorf("expect %v, actual %v", v.expect, string(actual))
+		}
+	}
+}
+
+func TestLogFilterValidate(t *testing.T) {
+	address := Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+	topic := Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	valids := []LogFilter{
+		{},
+		{FromEpoch: NewEpochNumber(big.NewInt(1)), ToEpoch: NewEpochNumber(big.NewInt(1))},
+		{FromEpoch: EpochEarliest, ToEpoch: EpochLatestState},
+		{FromEpoch: NewEpochNumber(big.NewInt(100)), ToEpoch: EpochLatestState},
+		{Address: []Address{address}, Topics: [][]Hash{{topic}, nil, nil, {topic}}},
+		{BlockHashes: []Hash{topic}},
+	}
+	for _, v := range valids {
+		if err := v.Validate(); err != nil {
+			t.Errorf("Test Validate %+v failed, error: %v", v, err)
+		}
+	}
+
+	invalids := []LogFilter{
+		{FromEpoch: NewEpochNumber(big.NewInt(2)), ToEpoch: NewEpochNumber(big.NewInt(1))},
+		{FromEpoch: EpochLatestState, ToEpoch: EpochEarliest},
+		{Topics: [][]Hash{nil, nil, nil, nil, {topic}}},
+		{Address: []Address{"0x8d5adbcaf5714924830591586f05302bf87f74"}},
+		{Address: []Address{"0x8d5adbcaf5714924830591586f05302bf87f74zz"}},
+		{Topics: [][]Hash{{"0xddf252ad"}}},
+		{BlockHashes: []Hash{"ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef00"}},
+	}
+	for _, v := range invalids {
+		if err := v.Validate(); err == nil {
+			t.Errorf("Test Validate %+v failed, expect error", v)
+		}
+	}
+}
+
+func TestLogFilterNormalize(t *testing.T) {
+	filter := LogFilter{FromEpoch: NewEpochNumber(big.NewInt(1))}
+	if err := filter.Normalize(); err != nil {
+		t.Fatal(err)
+	}
+	if filter.ToEpoch != EpochLatestState {
+		t.Errorf("Test Normalize failed, expect toEpoch %v, actual %v", EpochLatestState, filter.ToEpoch)
+	}
+
+	filter = LogFilter{BlockHashes: []Hash{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"}}
+	if err := filter.Normalize(); err != nil {
+		t.Fatal(err)
+	}
+	if filter.ToEpoch != nil {
+		t.Errorf("Test Normalize with block hashes failed, expect nil toEpoch, actual %v", filter.ToEpoch)
+	}
+}
+
+func TestLogFilterMultiAddressAndTopicsMarshal(t *testing.T) {
+	contract1 := Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+	contract2 := Address("0x8d5adbcaf5714924830591586f05302bf87f74be")
+	transfer := Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approval := Hash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	filter := NewLogFilter(NewEpochNumber(big.NewInt(1)), EpochLatestState).
+		AddressAny(contract1, contract2).
+		Topic0Any(transfer, approval)
+
+	expect := `{"fromEpoch":"0x1","toEpoch":"latest_state","address":["` + string(contract1) + `","` + string(contract2) +
+		`"],"topics":[["` + string(transfer) + `","` + string(approval) + `"]]}`
+	actual, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != expect {
+		t.Errorf("Test marshal multi-address and OR-topic filter failed, expect %v, actual %s", expect, actual)
+	}
+
+	var decoded LogFilter
+	if err := json.Unmarshal(actual, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if reencoded, _ := json.Marshal(decoded); string(reencoded) != expect {
+		t.Errorf("Test unmarshal multi-address and OR-topic filter failed, expect %v, actual %s", expect, reencoded)
+	}
+}
+
+func TestLogFilterUnmarshalSingleValues(t *testing.T) {
+	contract := Address("0x8d5adbcaf5714924830591586f05302bf87f74bd")
+	transfer := Hash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	input := `{"address":"` + string(contract) + `","topics":["` + string(transfer) + `",null]}`
+	var filter LogFilter
+	if err := json.Unmarshal([]byte(input), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := LogFilter{Address: []Address{contract}, Topics: [][]Hash{{transfer}, nil}}
+	if !reflect.DeepEqual(filter, expect) {
+		t.Errorf("Test unmarshal single address and topic failed, expect %+v, actual %+v", expect, filter)
+	}
+
+	if err := json.Unmarshal([]byte(`{"address":1}`), &filter); err == nil {
+		t.Error("Test unmarshal invalid address failed, expect error")
+	}
+}
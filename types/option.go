@@ -1,7 +1,7 @@
 package types
 
 import (
-	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -10,9 +10,11 @@ import (
 // ContractDeployOption for setting option when deploying contract
 type ContractDeployOption struct {
 	UnsignedTransactionBase
-	// TimeoutInSecond represents the timeout of deploy contract,
-	// default value is 0 which means never timeout
+	// Timeout represents the timeout of waiting for the deploying transaction executed,
+	// default value is 0 which means 1 hour, or the deadline of context if any when deploying with context
 	Timeout time.Duration
+	// PollInterval represents the interval of polling the deploying transaction, default value is 0 which means 2 seconds
+	PollInterval time.Duration
 }
 
 // ContractMethodCallOption for setting option when call contract method
@@ -27,19 +29,58 @@ type ContractMethodCallOption struct {
 	Epoch        *Epoch
 }
 
-// ContractMethodSendOption for setting option when call contract method
+// ContractMethodSendOption for setting option when send transaction to contract method.
+//
+// The non-nil fields take precedence and are never overwritten, while the nil fields are filled by
+// Client.ApplyUnsignedTransactionDefault, such as the nonce fetched from node or allocated by nonce manager.
 type ContractMethodSendOption UnsignedTransactionBase
 
+// TransactionWaitOption for setting option when waiting for transaction receipt
+type TransactionWaitOption struct {
+	// Timeout represents the timeout of waiting, default value is 0 which means 1 hour
+	Timeout time.Duration
+	// Confirmations represents the number of epochs executed after the epoch which packs the transaction,
+	// default value is 0 which means returning once the receipt is available
+	Confirmations uint64
+	// MaxRevertRate represents the max probability that the block which packs the transaction is reverted,
+	// default value is 0 which means not checked, see Client.WaitForConfirmedReceipt
+	MaxRevertRate float64
+}
+
+// CodeVerifyOption for setting tolerance when verifying deployed contract code
+type CodeVerifyOption struct {
+	// IgnoreMetadata strips the CBOR encoded metadata appended by solidity compiler from both codes before comparing
+	IgnoreMetadata bool
+	// IgnoreTrailingBytes ignores the specified number of trailing bytes of both codes when comparing
+	IgnoreTrailingBytes int
+}
+
 // CallRequest represents a request to execute contract.
 type CallRequest struct {
-	From         *Address     `json:"from,omitempty"`
-	To           *Address     `json:"to,omitempty"`
-	GasPrice     *hexutil.Big `json:"gasPrice,omitempty"`
-	Gas          *hexutil.Big `json:"gas,omitempty"`
-	Value        *hexutil.Big `json:"value,omitempty"`
-	Data         string       `json:"data,omitempty"`
-	Nonce        *hexutil.Big `json:"nonce,omitempty"`
-	StorageLimit *hexutil.Big `json:"storageLimit,omitempty"`
+	From         *Address      `json:"from,omitempty"`
+	To           *Address      `json:"to,omitempty"`
+	GasPrice     *hexutil.Big  `json:"gasPrice,omitempty"`
+	Gas          *hexutil.Big  `json:"gas,omitempty"`
+	Value        *hexutil.Big  `json:"value,omitempty"`
+	Data         hexutil.Bytes `json:"data,omitempty"`
+	Nonce        *hexutil.Big  `json:"nonce,omitempty"`
+	StorageLimit *hexutil.Big  `json:"storageLimit,omitempty"`
+}
+
+// Validate checks that From and To are well-formed if set, that is a 20 bytes HEX string with valid checksum if it
+// is in mixed-case. Data is always valid HEX as it is encoded from bytes.
+func (request *CallRequest) Validate() error {
+	if request.From != nil {
+		if err := request.From.ValidateChecksum(); err != nil {
+			return fmt.Errorf("from of call request is invalid: %v", err)
+		}
+	}
+	if request.To != nil {
+		if err := request.To.ValidateChecksum(); err != nil {
+			return fmt.Errorf("to of call request is invalid: %v", err)
+		}
+	}
+	return nil
 }
 
 // FillByUnsignedTx fills CallRequest fields by tx
@@ -55,8 +96,7 @@ func (request *CallRequest) FillByUnsignedTx(tx *UnsignedTransaction) {
 			request.Gas = tx.Gas
 		}
 
-		_data := "0x" + hex.EncodeToString(tx.Data)
-		request.Data = _data
+		request.Data = tx.Data
 
 		if tx.Nonce != nil {
 			request.Nonce = tx.Nonce
@@ -0,0 +1,81 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCallRequestDataJSON(t *testing.T) {
+	to := NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+	table := []struct {
+		data   []byte
+		expect string
+	}{
+		{nil, `{"to":"0x1cad0b19bb29d4674531d6f115237e16afce377d"}`},
+		{[]byte{}, `{"to":"0x1cad0b19bb29d4674531d6f115237e16afce377d"}`},
+		{[]byte{0xa9, 0x05, 0x9c, 0xbb}, `{"to":"0x1cad0b19bb29d4674531d6f115237e16afce377d","data":"0xa9059cbb"}`},
+	}
+
+	for _, v := range table {
+		request := CallRequest{To: to, Data: v.data}
+		actual, err := json.Marshal(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != v.expect {
+			t.Errorf("expect %v, actual %s", v.expect, actual)
+		}
+	}
+}
+
+func TestCallRequestFillByUnsignedTx(t *testing.T) {
+	tx := UnsignedTransaction{}
+	tx.To = NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+
+	var request CallRequest
+	request.FillByUnsignedTx(&tx)
+	if request.Data != nil {
+		t.Errorf("expect nil data for transfer, actual %v", request.Data)
+	}
+
+	tx.Data = []byte{0x12, 0x34}
+	request.FillByUnsignedTx(&tx)
+	if request.Data.String() != "0x1234" {
+		t.Errorf("expect data 0x1234, actual %v", request.Data)
+	}
+
+	var decoded CallRequest
+	if err := json.Unmarshal([]byte(`{"data":"0x1234"}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Data.String() != "0x1234" {
+		t.Errorf("expect decoded data 0x1234, actual %v", decoded.Data)
+	}
+}
+
+func TestCallRequestValidate(t *testing.T) {
+	valid := NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d")
+	malformed := NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce37")
+
+	table := []struct {
+		request CallRequest
+		isValid bool
+	}{
+		{CallRequest{}, true},
+		{CallRequest{From: valid, To: valid, Data: []byte{1, 2}}, true},
+		{CallRequest{From: malformed, To: valid}, false},
+		{CallRequest{From: valid, To: malformed}, false},
+	}
+
+	for i, v := range table {
+		err := v.request.Validate()
+		if (err == nil) != v.isValid {
+			t.Errorf("case %v: expect valid %v, got error %v", i, v.isValid, err)
+		}
+	}
+}
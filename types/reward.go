@@ -0,0 +1,12 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// RewardInfo represents the reward information of a block in drip
+type RewardInfo struct {
+	BlockHash   Hash         `json:"blockHash"`
+	Author      Address      `json:"author"`
+	TotalReward *hexutil.Big `json:"totalReward"`
+	BaseReward  *hexutil.Big `json:"baseReward"`
+	TxFee       *hexutil.Big `json:"txFee"`
+}
@@ -0,0 +1,23 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// SponsorInfo represents the sponsor information of a contract
+type SponsorInfo struct {
+	SponsorForGas               Address      `json:"sponsorForGas"`
+	SponsorForCollateral        Address      `json:"sponsorForCollateral"`
+	SponsorGasBound             *hexutil.Big `json:"sponsorGasBound"`
+	SponsorBalanceForGas        *hexutil.Big `json:"sponsorBalanceForGas"`
+	SponsorBalanceForCollateral *hexutil.Big `json:"sponsorBalanceForCollateral"`
+}
+
+// CheckBalanceAgainstTransactionResponse represents whether an account could afford a transaction to a contract,
+// considering the gas and storage collateral sponsored for the contract.
+type CheckBalanceAgainstTransactionResponse struct {
+	// WillPayTxFee is false if the gas fee is paid by the sponsor of contract
+	WillPayTxFee bool `json:"willPayTxFee"`
+	// WillPayCollateral is false if the storage collateral is paid by the sponsor of contract
+	WillPayCollateral bool `json:"willPayCollateral"`
+	// IsBalanceEnough is true if the balance of account is enough to pay for the parts not sponsored
+	IsBalanceEnough bool `json:"isBalanceEnough"`
+}
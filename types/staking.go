@@ -0,0 +1,16 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// DepositInfo represents a deposit of staking
+type DepositInfo struct {
+	Amount                  *hexutil.Big `json:"amount"`
+	AccumulatedInterestRate *hexutil.Big `json:"accumulatedInterestRate"`
+	DepositTime             *hexutil.Big `json:"depositTime"`
+}
+
+// VoteStakeInfo represents a vote of locked staking, the amount will be unlocked at the block number
+type VoteStakeInfo struct {
+	Amount            *hexutil.Big `json:"amount"`
+	UnlockBlockNumber *hexutil.Big `json:"unlockBlockNumber"`
+}
@@ -7,6 +7,7 @@ type Status struct {
 	BestHash        *Hash        `json:"bestHash"`
 	BlockNumber     *hexutil.Big `json:"blockNumber"`
 	ChainID         *hexutil.Big `json:"chainId"`
+	NetworkID       *hexutil.Big `json:"networkId,omitempty"`
 	EpochNumber     *hexutil.Big `json:"epochNumber"`
 	PendingTxNumber int          `json:"pendingTxNumber"`
 }
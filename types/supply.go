@@ -0,0 +1,11 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// SupplyInfo represents the supply of CFX in drip
+type SupplyInfo struct {
+	TotalIssued      *hexutil.Big `json:"totalIssued"`
+	TotalStaking     *hexutil.Big `json:"totalStaking"`
+	TotalCollateral  *hexutil.Big `json:"totalCollateral"`
+	TotalCirculating *hexutil.Big `json:"totalCirculating,omitempty"`
+}
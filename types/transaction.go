@@ -5,6 +5,12 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -31,21 +37,216 @@ type Transaction struct {
 	V *hexutil.Big `json:"v"`
 	R *hexutil.Big `json:"r"`
 	S *hexutil.Big `json:"s"`
+
+	// Extra holds the fields responded by conflux node but not supported by this SDK yet, such as the fields
+	// added by a newer node, it is nil if there is no such field.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the unknown fields are kept in Extra.
+func (tx *Transaction) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	type transactionJSON Transaction
+
+	var decoded transactionJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	extra, err := unknownJSONFields(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	*tx = Transaction(decoded)
+	tx.Extra = extra
+	return nil
+}
+
+// ToSignedTransaction converts the transaction returned by conflux node to the signed transaction,
+// which could be RLP encoded to the raw transaction or used to recover the sender from signature.
+func (tx *Transaction) ToSignedTransaction() (*SignedTransaction, error) {
+	if tx.V == nil || tx.R == nil || tx.S == nil {
+		return nil, errors.New("signature of transaction is incomplete")
+	}
+
+	data, err := hexutil.Decode(tx.Data)
+	if err != nil {
+		msg := fmt.Sprintf("decode data %v of transaction %v error", tx.Data, tx.Hash)
+		return nil, WrapError(err, msg)
+	}
+
+	from := tx.From
+	signed := SignedTransaction{
+		UnsignedTransaction: UnsignedTransaction{
+			UnsignedTransactionBase: UnsignedTransactionBase{
+				From:         &from,
+				Nonce:        tx.Nonce,
+				GasPrice:     tx.GasPrice,
+				Gas:          tx.Gas,
+				Value:        tx.Value,
+				StorageLimit: tx.StorageLimit,
+				EpochHeight:  tx.EpochHeight,
+				ChainID:      tx.ChainID,
+			},
+			To:   tx.To,
+			Data: data,
+		},
+		V: byte(tx.V.ToInt().Uint64()),
+		R: common.LeftPadBytes(tx.R.ToInt().Bytes(), 32),
+		S: common.LeftPadBytes(tx.S.ToInt().Bytes(), 32),
+	}
+	return &signed, nil
+}
+
+// VerifiedTransaction represents a transaction returned by conflux node along with the raw transaction
+// and the sender recovered locally from its signature.
+type VerifiedTransaction struct {
+	Transaction *Transaction
+	// Raw is the RLP encoded signed transaction
+	Raw hexutil.Bytes
+	// RecoveredFrom is the sender recovered from the signature of transaction
+	RecoveredFrom Address
+	// FromMatched is true if RecoveredFrom is the same as the from reported by node
+	FromMatched bool
+	// HashMatched is true if the hash of Raw is the same as the hash reported by node
+	HashMatched bool
+}
+
+// TransactionState represents the state of a submitted transaction
+type TransactionState int
+
+// Transaction states in the order of a transaction's lifecycle.
+const (
+	// TransactionNotFound means the transaction is unknown to node, such as it is dropped from the transaction pool
+	TransactionNotFound TransactionState = iota
+	// TransactionPending means the transaction is in the transaction pool but not packed yet
+	TransactionPending
+	// TransactionPacked means the transaction is packed in a block but not executed yet
+	TransactionPacked
+	// TransactionExecuted means the transaction is executed, no matter it succeeded or failed
+	TransactionExecuted
+)
+
+// String implements the fmt.Stringer interface
+func (state TransactionState) String() string {
+	switch state {
+	case TransactionNotFound:
+		return "not found"
+	case TransactionPending:
+		return "pending"
+	case TransactionPacked:
+		return "packed"
+	case TransactionExecuted:
+		return "executed"
+	}
+	return fmt.Sprintf("TransactionState(%d)", int(state))
+}
+
+// State returns the state of transaction according to its block hash and status,
+// it returns TransactionNotFound if tx is nil.
+func (tx *Transaction) State() TransactionState {
+	switch {
+	case tx == nil:
+		return TransactionNotFound
+	case tx.BlockHash == nil:
+		return TransactionPending
+	case tx.Status == nil:
+		return TransactionPacked
+	default:
+		return TransactionExecuted
+	}
+}
+
+// OutcomeStatus represents the execution outcome of a transaction
+type OutcomeStatus uint8
+
+// Outcome statuses of transaction execution.
+const (
+	// OutcomeSuccess means the transaction is executed successfully
+	OutcomeSuccess OutcomeStatus = iota
+	// OutcomeFailed means the transaction is executed but failed, such as reverted or out of gas
+	OutcomeFailed
+	// OutcomeSkipped means the transaction is skipped in the block, such as it is already executed in another block
+	OutcomeSkipped
+)
+
+// String implements the fmt.Stringer interface
+func (status OutcomeStatus) String() string {
+	switch status {
+	case OutcomeSuccess:
+		return "success"
+	case OutcomeFailed:
+		return "failed"
+	case OutcomeSkipped:
+		return "skipped"
+	}
+	return fmt.Sprintf("OutcomeStatus(%d)", uint8(status))
+}
+
+// OutcomeStatus returns the execution outcome of transaction, and false if it is not executed yet.
+func (tx *Transaction) OutcomeStatus() (OutcomeStatus, bool) {
+	if tx == nil || tx.Status == nil {
+		return 0, false
+	}
+	return OutcomeStatus(tx.Status.ToInt().Uint64()), true
 }
 
 // TransactionReceipt represents the transaction execution result in Conflux.
 // it is the response from conflux node when sending rpc request, such as cfx_getTransactionReceipt
 type TransactionReceipt struct {
-	TransactionHash Hash         `json:"transactionHash"`
-	Index           uint         `json:"index"`
-	BlockHash       Hash         `json:"blockHash"`
-	EpochNumber     *uint64      `json:"epochNumber,omitempty"`
-	From            Address      `json:"from"`
-	To              *Address     `json:"to,omitempty"`
-	GasUsed         *hexutil.Big `json:"gasUsed"`
-	ContractCreated *Address     `json:"contractCreated,omitempty"`
-	Logs            []LogEntry   `json:"logs"`
-	LogsBloom       Bloom        `json:"logsBloom"`
-	StateRoot       Hash         `json:"stateRoot"`
-	OutcomeStatus   uint8        `json:"outcomeStatus"`
+	TransactionHash Hash          `json:"transactionHash"`
+	Index           uint          `json:"index"`
+	BlockHash       Hash          `json:"blockHash"`
+	EpochNumber     *uint64       `json:"epochNumber,omitempty"`
+	From            Address       `json:"from"`
+	To              *Address      `json:"to,omitempty"`
+	GasUsed         *hexutil.Big  `json:"gasUsed"`
+	ContractCreated *Address      `json:"contractCreated,omitempty"`
+	Logs            []LogEntry    `json:"logs"`
+	LogsBloom       Bloom         `json:"logsBloom"`
+	StateRoot       Hash          `json:"stateRoot"`
+	OutcomeStatus   OutcomeStatus `json:"outcomeStatus"`
+
+	StorageCollateralized   *hexutil.Big    `json:"storageCollateralized,omitempty"`
+	StorageCoveredBySponsor bool            `json:"storageCoveredBySponsor"`
+	StorageReleased         []StorageChange `json:"storageReleased,omitempty"`
+}
+
+// IsSuccess returns true if the transaction is executed successfully
+func (r *TransactionReceipt) IsSuccess() bool {
+	return r.OutcomeStatus == OutcomeSuccess
+}
+
+// StorageChange represents the storage collateral released from an address
+type StorageChange struct {
+	Address     Address      `json:"address"`
+	Collaterals *hexutil.Big `json:"collaterals"`
+}
+
+// StorageChanges returns the storage collateral collateralized by the transaction sender
+// and the total storage collateral released from all addresses in the transaction.
+func (r *TransactionReceipt) StorageChanges() (collateralized, released *big.Int) {
+	collateralized = big.NewInt(0)
+	if r.StorageCollateralized != nil {
+		collateralized.Set(r.StorageCollateralized.ToInt())
+	}
+
+	released = big.NewInt(0)
+	for _, change := range r.StorageReleased {
+		if change.Collaterals != nil {
+			released.Add(released, change.Collaterals.ToInt())
+		}
+	}
+	return collateralized, released
+}
+
+// MinedTransaction represents a transaction packed in a block, along with the hash and epoch number of the block
+type MinedTransaction struct {
+	Transaction *Transaction
+	BlockHash   Hash
+	EpochNumber *hexutil.Big
 }
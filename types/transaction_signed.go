@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -29,6 +31,21 @@ type SignedTransaction struct {
 	S                   hexutil.Bytes
 }
 
+// Signature represents the ECDSA signature of a transaction
+type Signature struct {
+	V byte
+	R hexutil.Bytes
+	S hexutil.Bytes
+}
+
+// Bytes returns the 65 bytes signature in the [R || S || V] format
+func (sig Signature) Bytes() []byte {
+	result := make([]byte, 0, 65)
+	result = append(result, common.LeftPadBytes(sig.R, 32)...)
+	result = append(result, common.LeftPadBytes(sig.S, 32)...)
+	return append(result, sig.V)
+}
+
 // Decode decodes RLP encoded data to tx
 func (tx *SignedTransaction) Decode(data []byte) error {
 	txForRlp := new(signedTransactionForRlp)
@@ -54,6 +71,37 @@ func (tx *SignedTransaction) Encode() ([]byte, error) {
 	return encoded, nil
 }
 
+// Hash returns the transaction hash, that is the keccak256 of the RLP encoded signed transaction.
+func (tx *SignedTransaction) Hash() ([]byte, error) {
+	encoded, err := tx.Encode()
+	if err != nil {
+		msg := fmt.Sprintf("encode tx {%+v} error", tx)
+		return nil, WrapError(err, msg)
+	}
+
+	return crypto.Keccak256(encoded), nil
+}
+
+// Sender recovers the address which signed tx from its signature. Note the returned address is the
+// ethereum style address recovered from the public key, use utils.ToCfxGeneralAddress to convert it
+// to the conflux address.
+func (tx *SignedTransaction) Sender() (common.Address, error) {
+	hash, err := tx.UnsignedTransaction.Hash()
+	if err != nil {
+		msg := fmt.Sprintf("calculate tx hash of %+v error", tx.UnsignedTransaction)
+		return common.Address{}, WrapError(err, msg)
+	}
+
+	sig := Signature{V: tx.V, R: tx.R, S: tx.S}
+	pubKey, err := crypto.SigToPub(hash, sig.Bytes())
+	if err != nil {
+		msg := fmt.Sprintf("recover public key from signature {%x} error", sig.Bytes())
+		return common.Address{}, WrapError(err, msg)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
 func (tx *SignedTransaction) toStructForRlp() *signedTransactionForRlp {
 	txForRlp := signedTransactionForRlp{
 		UnsignedData: tx.UnsignedTransaction.toStructForRlp(),
@@ -68,8 +116,8 @@ func (tx *signedTransactionForRlp) toSignedTransaction() *SignedTransaction {
 	unsigned := tx.UnsignedData.toUnsignedTransaction()
 	return &SignedTransaction{
 		UnsignedTransaction: *unsigned,
-		V:                   tx.V.Bytes()[0],
-		R:                   tx.R.Bytes(),
-		S:                   tx.S.Bytes(),
+		V:                   byte(tx.V.Uint64()),
+		R:                   common.LeftPadBytes(tx.R.Bytes(), 32),
+		S:                   common.LeftPadBytes(tx.S.Bytes(), 32),
 	}
 }
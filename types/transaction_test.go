@@ -0,0 +1,227 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestReceiptStorageChanges(t *testing.T) {
+	receiptJSON := `{
+		"transactionHash": "0x53fe995edeec7d241791ff32635244e94ecfd722c9fe90f34ddf59082d814514",
+		"index": 0,
+		"blockHash": "0xbb1eea3c8a574dc19f7d8311a2096e23a39f12e649a20766544f2df67aac0bed",
+		"from": "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		"to": "0x8d5adbcaf5714924830591586f05302bf87f74bd",
+		"gasUsed": "0x7d23",
+		"logs": [],
+		"logsBloom": "0x00",
+		"stateRoot": "0xd6a8c3e0b7fcc3c6e7b7ebd0c3e0a4ca6a2a5d30fcf7f6c6e0b1e2f3a4b5c6d7",
+		"outcomeStatus": 0,
+		"storageCollateralized": "0x40",
+		"storageCoveredBySponsor": false,
+		"storageReleased": [
+			{"address": "0x1cad0b19bb29d4674531d6f115237e16afce377c", "collaterals": "0x20"},
+			{"address": "0x1cad0b19bb29d4674531d6f115237e16afce377d", "collaterals": "0x40"}
+		]
+	}`
+
+	var receipt TransactionReceipt
+	if err := json.Unmarshal([]byte(receiptJSON), &receipt); err != nil {
+		t.Fatalf("unmarshal receipt error: %v", err)
+	}
+
+	collateralized, released := receipt.StorageChanges()
+	if collateralized.Int64() != 64 {
+		t.Errorf("expect collateralized 64, actual %v", collateralized)
+	}
+	if released.Int64() != 96 {
+		t.Errorf("expect released 96, actual %v", released)
+	}
+	if receipt.StorageReleased[1].Address != Address("0x1cad0b19bb29d4674531d6f115237e16afce377d") {
+		t.Errorf("expect released address 0x1cad0b19bb29d4674531d6f115237e16afce377d, actual %v", receipt.StorageReleased[1].Address)
+	}
+
+	var empty TransactionReceipt
+	collateralized, released = empty.StorageChanges()
+	if collateralized.Sign() != 0 || released.Sign() != 0 {
+		t.Errorf("expect no storage changes, actual collateralized %v, released %v", collateralized, released)
+	}
+}
+
+func TestReceiptAddresses(t *testing.T) {
+	deployJSON := `{
+		"transactionHash": "0x53fe995edeec7d241791ff32635244e94ecfd722c9fe90f34ddf59082d814514",
+		"from": "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		"to": null,
+		"contractCreated": "0x8d5adbcaf5714924830591586f05302bf87f74bd",
+		"outcomeStatus": 0
+	}`
+
+	var receipt TransactionReceipt
+	if err := json.Unmarshal([]byte(deployJSON), &receipt); err != nil {
+		t.Fatalf("unmarshal receipt error: %v", err)
+	}
+	if receipt.From != Address("0x1cad0b19bb29d4674531d6f115237e16afce377c") {
+		t.Errorf("expect from 0x1cad0b19bb29d4674531d6f115237e16afce377c, actual %v", receipt.From)
+	}
+	if receipt.To != nil {
+		t.Errorf("expect to be nil for deploy receipt, actual %v", *receipt.To)
+	}
+	if receipt.ContractCreated == nil || *receipt.ContractCreated != Address("0x8d5adbcaf5714924830591586f05302bf87f74bd") {
+		t.Errorf("expect contractCreated 0x8d5adbcaf5714924830591586f05302bf87f74bd, actual %v", receipt.ContractCreated)
+	}
+
+	transferJSON := `{
+		"transactionHash": "0x53fe995edeec7d241791ff32635244e94ecfd722c9fe90f34ddf59082d814514",
+		"from": "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		"to": "0x1cad0b19bb29d4674531d6f115237e16afce377d",
+		"contractCreated": null,
+		"outcomeStatus": 0
+	}`
+
+	receipt = TransactionReceipt{}
+	if err := json.Unmarshal([]byte(transferJSON), &receipt); err != nil {
+		t.Fatalf("unmarshal receipt error: %v", err)
+	}
+	if receipt.To == nil || *receipt.To != Address("0x1cad0b19bb29d4674531d6f115237e16afce377d") {
+		t.Errorf("expect to 0x1cad0b19bb29d4674531d6f115237e16afce377d, actual %v", receipt.To)
+	}
+	if receipt.ContractCreated != nil {
+		t.Errorf("expect contractCreated be nil, actual %v", *receipt.ContractCreated)
+	}
+}
+
+func TestTransactionAddresses(t *testing.T) {
+	txJSON := `{
+		"hash": "0x53fe995edeec7d241791ff32635244e94ecfd722c9fe90f34ddf59082d814514",
+		"from": "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		"to": null,
+		"contractCreated": "0x8d5adbcaf5714924830591586f05302bf87f74bd",
+		"data": "0x"
+	}`
+
+	var tx Transaction
+	if err := json.Unmarshal([]byte(txJSON), &tx); err != nil {
+		t.Fatalf("unmarshal transaction error: %v", err)
+	}
+	if tx.To != nil {
+		t.Errorf("expect to be nil for deploy transaction, actual %v", *tx.To)
+	}
+	if tx.ContractCreated == nil || *tx.ContractCreated != Address("0x8d5adbcaf5714924830591586f05302bf87f74bd") {
+		t.Errorf("expect contractCreated 0x8d5adbcaf5714924830591586f05302bf87f74bd, actual %v", tx.ContractCreated)
+	}
+}
+
+func TestTransactionState(t *testing.T) {
+	blockHash := Hash("0xb1")
+	table := []struct {
+		tx     *Transaction
+		expect TransactionState
+	}{
+		{nil, TransactionNotFound},
+		{&Transaction{}, TransactionPending},
+		{&Transaction{BlockHash: &blockHash}, TransactionPacked},
+		{&Transaction{BlockHash: &blockHash, Status: NewBigInt(1)}, TransactionExecuted},
+	}
+
+	for _, v := range table {
+		if actual := v.tx.State(); actual != v.expect {
+			t.Errorf("Test State of %+v failed, expect %v, actual %v", v.tx, v.expect, actual)
+		}
+	}
+}
+
+func TestOutcomeStatus(t *testing.T) {
+	var receipt TransactionReceipt
+	if err := json.Unmarshal([]byte(`{"outcomeStatus": 1}`), &receipt); err != nil {
+		t.Fatal(err)
+	}
+	if receipt.OutcomeStatus != OutcomeFailed || receipt.IsSuccess() {
+		t.Errorf("expect failed outcome, got %v", receipt.OutcomeStatus)
+	}
+
+	expects := map[OutcomeStatus]string{
+		OutcomeSuccess:   "success",
+		OutcomeFailed:    "failed",
+		OutcomeSkipped:   "skipped",
+		OutcomeStatus(9): "OutcomeStatus(9)",
+	}
+	for status, expect := range expects {
+		if got := status.String(); got != expect {
+			t.Errorf("expect %v, got %v", expect, got)
+		}
+	}
+
+	var tx Transaction
+	if _, executed := tx.OutcomeStatus(); executed {
+		t.Error("expect transaction not executed")
+	}
+	tx.Status = NewBigInt(2)
+	if status, executed := tx.OutcomeStatus(); !executed || status != OutcomeSkipped {
+		t.Errorf("expect skipped outcome, got %v", status)
+	}
+}
+
+func TestSignedTransactionSender(t *testing.T) {
+	utx := UnsignedTransaction{
+		UnsignedTransactionBase: UnsignedTransactionBase{
+			Nonce:        NewBigInt(1),
+			GasPrice:     NewBigInt(10),
+			Gas:          NewBigInt(21000),
+			Value:        NewBigInt(100),
+			StorageLimit: NewBigInt(0),
+			EpochHeight:  NewBigInt(100),
+			ChainID:      NewBigInt(1),
+		},
+		To: NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"),
+	}
+
+	privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	signed, err := utx.Sign(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		From:         "0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		To:           utx.To,
+		Nonce:        utx.Nonce,
+		GasPrice:     utx.GasPrice,
+		Gas:          utx.Gas,
+		Value:        utx.Value,
+		StorageLimit: utx.StorageLimit,
+		EpochHeight:  utx.EpochHeight,
+		ChainID:      utx.ChainID,
+		Data:         "0x",
+		V:            NewBigInt(int64(signed.V)),
+		R:            (*hexutil.Big)(new(big.Int).SetBytes(signed.R)),
+		S:            (*hexutil.Big)(new(big.Int).SetBytes(signed.S)),
+	}
+	converted, err := tx.ToSignedTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect, _ := signed.Encode()
+	if actual, _ := converted.Encode(); !reflect.DeepEqual(actual, expect) {
+		t.Errorf("expect raw transaction %x, actual %x", expect, actual)
+	}
+
+	sender, err := converted.Sender()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != crypto.PubkeyToAddress(privateKey.PublicKey) {
+		t.Errorf("expect sender %x, actual %x", crypto.PubkeyToAddress(privateKey.PublicKey), sender)
+	}
+
+	tx.V = nil
+	if _, err := tx.ToSignedTransaction(); err == nil {
+		t.Error("expect error for incomplete signature")
+	}
+}
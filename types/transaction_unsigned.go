@@ -5,10 +5,12 @@
 package types
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 	"strings"
 
+	"github.com/Conflux-Chain/go-conflux-sdk/constants"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -70,7 +72,43 @@ func (tx *UnsignedTransaction) ApplyDefault() {
 	}
 }
 
-// Hash hashes the tx by keccak256 and returns the result
+// Cost returns the breakdown of the max cost of tx, which requires gas, gas price and storage limit are filled,
+// such as by Client.ApplyUnsignedTransactionDefault. The storage collateral is calculated with collateralPerByte
+// in Drip if specified, otherwise constants.DefaultCollateralPerByte is used.
+func (tx *UnsignedTransaction) Cost(collateralPerByte ...*big.Int) (*TransactionCost, error) {
+	if tx.Gas == nil || tx.GasPrice == nil || tx.StorageLimit == nil {
+		return nil, fmt.Errorf("gas, gas price and storage limit of transaction are necessary for calculating cost, got %v, %v and %v",
+			tx.Gas, tx.GasPrice, tx.StorageLimit)
+	}
+
+	perByte := big.NewInt(constants.DefaultCollateralPerByte)
+	if len(collateralPerByte) > 0 && collateralPerByte[0] != nil {
+		perByte = collateralPerByte[0]
+	}
+
+	cost := &TransactionCost{
+		GasFee:            new(big.Int).Mul(tx.Gas.ToInt(), tx.GasPrice.ToInt()),
+		StorageCollateral: new(big.Int).Mul(tx.StorageLimit.ToInt(), perByte),
+		Value:             big.NewInt(0),
+	}
+	if tx.Value != nil {
+		cost.Value.Set(tx.Value.ToInt())
+	}
+
+	cost.Total = new(big.Int).Add(cost.GasFee, cost.StorageCollateral)
+	cost.Total.Add(cost.Total, cost.Value)
+	return cost, nil
+}
+
+// Hash returns the hash which must be signed by the sender of tx, such as by an external signer (HSM, cloud KMS).
+//
+// The preimage is the RLP encoding of the list
+// [nonce, gasPrice, gas, to, value, storageLimit, epochHeight, chainId, data] as returned by Encode,
+// where the integers are encoded as big endian without leading zeros, to is the 20 bytes address or empty
+// for contract creation, and the hash is keccak256(preimage).
+//
+// The signer signs the hash by secp256k1 to produce r, s and the recovery id v (0 or 1),
+// which could be passed to EncodeWithSignature or Client.SignEncodedTransactionAndSend.
 func (tx *UnsignedTransaction) Hash() ([]byte, error) {
 	encoded, err := tx.Encode()
 	if err != nil {
@@ -81,6 +119,28 @@ func (tx *UnsignedTransaction) Hash() ([]byte, error) {
 	return crypto.Keccak256(encoded), nil
 }
 
+// Sign signs tx with privateKey without keystore, and returns the transaction with signature.
+func (tx *UnsignedTransaction) Sign(privateKey *ecdsa.PrivateKey) (*SignedTransaction, error) {
+	hash, err := tx.Hash()
+	if err != nil {
+		msg := fmt.Sprintf("calculate tx hash of %+v error", tx)
+		return nil, WrapError(err, msg)
+	}
+
+	sig, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		msg := fmt.Sprintf("sign tx hash {%+x} error", hash)
+		return nil, WrapError(err, msg)
+	}
+
+	signedTx := new(SignedTransaction)
+	signedTx.UnsignedTransaction = *tx
+	signedTx.V = sig[64]
+	signedTx.R = sig[0:32]
+	signedTx.S = sig[32:64]
+	return signedTx, nil
+}
+
 //Encode encodes tx and returns its RLP encoded data
 func (tx *UnsignedTransaction) Encode() ([]byte, error) {
 	data := *tx.toStructForRlp()
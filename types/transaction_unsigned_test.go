@@ -6,8 +6,11 @@ package types
 
 import (
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func TestEncode(t *testing.T) {
@@ -102,3 +105,106 @@ func TestDecodeRlpToUnsignTransction(t *testing.T) {
 		t.Errorf("\njson of expect is %+v,\njson of acutal is %+v", expect, actual)
 	}
 }
+
+func TestHashDiffersByChainID(t *testing.T) {
+	utx := UnsignedTransaction{
+		UnsignedTransactionBase: UnsignedTransactionBase{
+			Nonce:        NewBigInt(16),
+			GasPrice:     NewBigInt(32),
+			Gas:          NewBigInt(64),
+			Value:        NewBigInt(128),
+			StorageLimit: NewBigInt(256),
+			EpochHeight:  NewBigInt(512),
+			ChainID:      NewBigInt(1029),
+		},
+		To: NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"),
+	}
+	mainnetHash, _ := utx.Hash()
+
+	utx.ChainID = NewBigInt(1)
+	testnetHash, _ := utx.Hash()
+
+	if reflect.DeepEqual(mainnetHash, testnetHash) {
+		t.Errorf("expect hashes differ by chain id, both are %x", mainnetHash)
+	}
+}
+
+func TestCost(t *testing.T) {
+	tx := UnsignedTransaction{}
+	tx.Gas = NewBigInt(21000)
+	tx.GasPrice = NewBigInt(1000000000)
+	tx.StorageLimit = NewBigInt(1024)
+	tx.Value = NewBigInt(5)
+
+	cost, err := tx.Cost()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]string{
+		"GasFee":            "21000000000000",
+		"StorageCollateral": "1000000000000000000",
+		"Value":             "5",
+		"Total":             "1000021000000000005",
+	}
+	actual := map[string]string{
+		"GasFee":            cost.GasFee.String(),
+		"StorageCollateral": cost.StorageCollateral.String(),
+		"Value":             cost.Value.String(),
+		"Total":             cost.Total.String(),
+	}
+	if !reflect.DeepEqual(expect, actual) {
+		t.Errorf("Test Cost failed, expect %v, actual %v", expect, actual)
+	}
+
+	cost, err = tx.Cost(big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost.StorageCollateral.Int64() != 1024 || cost.Total.Int64() != 21000000001029 {
+		t.Errorf("Test Cost with custom collateral per byte failed, actual %+v", cost)
+	}
+
+	tx.StorageLimit = nil
+	if _, err := tx.Cost(); err == nil {
+		t.Error("Test Cost without storage limit failed, expect error")
+	}
+}
+
+func TestHashAndSign(t *testing.T) {
+	utx := UnsignedTransaction{
+		UnsignedTransactionBase: UnsignedTransactionBase{
+			Nonce:        NewBigInt(16),
+			GasPrice:     NewBigInt(32),
+			Gas:          NewBigInt(64),
+			Value:        NewBigInt(128),
+			StorageLimit: NewBigInt(256),
+			EpochHeight:  NewBigInt(512),
+			ChainID:      NewBigInt(1029),
+		},
+		To: NewAddress("0x1cad0b19bb29d4674531d6f115237e16afce377d"),
+	}
+
+	encoded, _ := utx.Encode()
+	hash, err := utx.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hash, crypto.Keccak256(encoded)) {
+		t.Errorf("Test Hash failed, expect keccak256 of rlp encoding %x, actual %x", crypto.Keccak256(encoded), hash)
+	}
+
+	privateKey, _ := crypto.HexToECDSA("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	signedTx, err := utx.Sign(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig := append(append(append([]byte{}, signedTx.R...), signedTx.S...), signedTx.V)
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != crypto.PubkeyToAddress(privateKey.PublicKey) {
+		t.Errorf("Test Sign failed, the signer recovered from signature is %x", crypto.PubkeyToAddress(*pubKey))
+	}
+}
@@ -5,22 +5,42 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/Conflux-Chain/go-conflux-sdk/constants"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // Address represents the 20 byte address of an Conflux account in HEX format.
 type Address string
 
-// NewAddress creates a address with specified HEX string.
+// NewAddress creates a address with specified HEX string without validation,
+// use ParseAddress instead to catch the malformed address before sending it to conflux node.
 func NewAddress(hexAddress string) *Address {
 	addr := Address(hexAddress)
 	return &addr
 }
 
+// ParseAddress creates a address with specified HEX string, and returns error if it is not a valid 20 bytes
+// HEX string, or it is in mixed-case but not matches its checksum.
+func ParseAddress(hexAddress string) (Address, error) {
+	addr := Address(hexAddress)
+	if err := addr.ValidateChecksum(); err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// IsValid returns true if address is a valid 20 bytes HEX string with valid checksum if it is in mixed-case.
+func (address *Address) IsValid() bool {
+	return address.ValidateChecksum() == nil
+}
+
 // String implements the interface stringer
 func (address *Address) String() string {
 	return string(*address)
@@ -39,6 +59,52 @@ func (address *Address) IsZero() bool {
 	return *tmp == constants.ZeroAddress
 }
 
+// ToChecksumHex returns the mixed-case checksum HEX format of address, which is the same as EIP-55.
+func (address *Address) ToChecksumHex() string {
+	lower := hex.EncodeToString(address.ToCommonAddress().Bytes())
+	hash := crypto.Keccak256([]byte(lower))
+
+	result := []byte(lower)
+	for i := range result {
+		// the i-th hex char is upper case if the i-th nibble of hash is not less than 8
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble = nibble >> 4
+		}
+		if result[i] >= 'a' && nibble&0x0f >= 8 {
+			result[i] -= 'a' - 'A'
+		}
+	}
+	return "0x" + string(result)
+}
+
+// ValidateChecksum returns error if address is not a valid 20 bytes HEX string,
+// or it is in mixed-case but not matches its checksum. An all lower-case or
+// all upper-case address is valid as there is no checksum.
+func (address *Address) ValidateChecksum() error {
+	str := string(*address)
+	if !strings.HasPrefix(str, "0x") && !strings.HasPrefix(str, "0X") {
+		return fmt.Errorf("address %v should start with 0x", str)
+	}
+
+	body := str[2:]
+	if len(body) != 2*common.AddressLength {
+		return fmt.Errorf("address %v should be %v bytes", str, common.AddressLength)
+	}
+	if _, err := hex.DecodeString(body); err != nil {
+		return fmt.Errorf("address %v is not a valid HEX string", str)
+	}
+
+	if body == strings.ToLower(body) || body == strings.ToUpper(body) {
+		return nil
+	}
+
+	if expect := address.ToChecksumHex(); body != expect[2:] {
+		return fmt.Errorf("address %v has invalid checksum, expect %v", str, expect)
+	}
+	return nil
+}
+
 // Hash represents the 32 byte Keccak256 hash of arbitrary data in HEX format.
 type Hash string
 
@@ -64,6 +130,7 @@ func NewBigInt(x int64) *hexutil.Big {
 }
 
 // NewBigIntByRaw creates a hexutil.big with specified big.int value.
+// The result shares the underlying value with x, use ToHexBig to copy it.
 func NewBigIntByRaw(x *big.Int) *hexutil.Big {
 	if x == nil {
 		return nil
@@ -71,3 +138,26 @@ func NewBigIntByRaw(x *big.Int) *hexutil.Big {
 	v := hexutil.Big(*x)
 	return &v
 }
+
+// NewBigIntUint64 creates a big number with specified uint64 value.
+func NewBigIntUint64(x uint64) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(x))
+}
+
+// ToHexBig converts x to *hexutil.Big, which is used by the fields of transaction and options, the value is copied
+// so that changing x later does not affect the result. It returns nil if x is nil.
+func ToHexBig(x *big.Int) *hexutil.Big {
+	if x == nil {
+		return nil
+	}
+	return (*hexutil.Big)(new(big.Int).Set(x))
+}
+
+// ToBigInt converts x to *big.Int, which is returned by the client methods such as GetBalance, the value is copied
+// so that changing the result does not affect x. It returns nil if x is nil.
+func ToBigInt(x *hexutil.Big) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x.ToInt())
+}
@@ -1,6 +1,13 @@
 package types
 
-import "testing"
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
 
 func TestAddressIsZero(t *testing.T) {
 	zeroAddrs := []Address{Address("0x0000000000000000000000000000000000000000"), Address("0X0000000000000000000000000000000000000000")}
@@ -21,3 +28,104 @@ func TestAddressIsZero(t *testing.T) {
 		t.Errorf("expect %+v be zero address", &normalAddr)
 	}
 }
+
+func TestAddressToChecksumHex(t *testing.T) {
+	checksumAddrs := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0x1Cad0B19bB29d4674531d6f115237E16afce377C",
+		"0x8D5aDBcaF5714924830591586F05302BF87f74bd",
+	}
+	for _, expect := range checksumAddrs {
+		lower := Address(strings.ToLower(expect))
+		if actual := lower.ToChecksumHex(); actual != expect {
+			t.Errorf("expect checksum address %v, actual %v", expect, actual)
+		}
+
+		addr := Address(expect)
+		if err := addr.ValidateChecksum(); err != nil {
+			t.Errorf("expect %v be valid, got error %v", expect, err)
+		}
+	}
+}
+
+func TestAddressValidateChecksum(t *testing.T) {
+	validAddrs := []Address{
+		"0x1cad0b19bb29d4674531d6f115237e16afce377c",
+		"0x1CAD0B19BB29D4674531D6F115237E16AFCE377C",
+	}
+	for _, addr := range validAddrs {
+		if err := addr.ValidateChecksum(); err != nil {
+			t.Errorf("expect %v be valid, got error %v", addr, err)
+		}
+	}
+
+	invalidAddrs := []Address{
+		"0x1cAd0B19bB29d4674531d6f115237E16afce377C",
+		"0x1cad0b19bb29d4674531d6f115237e16afce37",
+		"1cad0b19bb29d4674531d6f115237e16afce377c",
+		"0x1cad0b19bb29d4674531d6f115237e16afce377g",
+	}
+	for _, addr := range invalidAddrs {
+		if err := addr.ValidateChecksum(); err == nil {
+			t.Errorf("expect %v be invalid", addr)
+		}
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	addr, err := ParseAddress("0x1cad0b19bb29d4674531d6f115237e16afce377c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != "0x1cad0b19bb29d4674531d6f115237e16afce377c" || !addr.IsValid() {
+		t.Errorf("expect valid address, got %v", addr)
+	}
+
+	for _, s := range []string{"0x19f4", "0x1cAd0B19bB29d4674531d6f115237E16afce377C", ""} {
+		if addr, err := ParseAddress(s); err == nil || addr != "" {
+			t.Errorf("expect %v be invalid, got %v", s, addr)
+		}
+		if addr := Address(s); addr.IsValid() {
+			t.Errorf("expect %v be invalid", s)
+		}
+	}
+}
+
+func TestBigIntConversion(t *testing.T) {
+	if ToHexBig(nil) != nil || ToBigInt(nil) != nil {
+		t.Errorf("expect nil converted to nil")
+	}
+
+	x, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	hexBig := ToHexBig(x)
+	if hexBig.ToInt().Cmp(x) != 0 {
+		t.Errorf("expect %v, actual %v", x, hexBig.ToInt())
+	}
+
+	x.SetInt64(1)
+	if hexBig.ToInt().Cmp(x) == 0 {
+		t.Errorf("expect ToHexBig copies the value")
+	}
+
+	converted := ToBigInt(hexBig)
+	converted.SetInt64(2)
+	if hexBig.ToInt().Int64() == 2 {
+		t.Errorf("expect ToBigInt copies the value")
+	}
+
+	if NewBigIntUint64(1<<63).ToInt().Uint64() != 1<<63 {
+		t.Errorf("expect uint64 value kept")
+	}
+
+	// the wire format is unchanged
+	encoded, _ := json.Marshal(ToHexBig(big.NewInt(255)))
+	if string(encoded) != `"0xff"` {
+		t.Errorf("expect 0xff, actual %s", encoded)
+	}
+
+	var decoded *hexutil.Big
+	if err := json.Unmarshal(encoded, &decoded); err != nil || ToBigInt(decoded).Int64() != 255 {
+		t.Errorf("expect 255 decoded, actual %v, error %v", decoded, err)
+	}
+}
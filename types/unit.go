@@ -0,0 +1,99 @@
+// Copyright 2019 Conflux Foundation. All rights reserved.
+// Conflux is free software and distributed under GNU General Public License.
+// See http://www.gnu.org/licenses/
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Conflux-Chain/go-conflux-sdk/constants"
+)
+
+// gDripDecimal is the number of decimals of GDrip, 1 GDrip = 10^9 Drip
+const gDripDecimal = 9
+
+// ParseCFX parses the decimal string of CFX amount, such as "1.5", to the amount in Drip.
+// It returns error if the string has more than 18 decimals or the amount overflows uint256.
+func ParseCFX(cfx string) (*big.Int, error) {
+	return parseUnit(cfx, constants.CFXDecimal)
+}
+
+// FormatCFX formats the amount in Drip to the decimal string of CFX without trailing zeros, such as "1.5".
+func FormatCFX(drip *big.Int) string {
+	return formatUnit(drip, constants.CFXDecimal)
+}
+
+// ParseGDrip parses the decimal string of GDrip amount, such as "1.5", to the amount in Drip.
+// It returns error if the string has more than 9 decimals or the amount overflows uint256.
+func ParseGDrip(gdrip string) (*big.Int, error) {
+	return parseUnit(gdrip, gDripDecimal)
+}
+
+// FormatGDrip formats the amount in Drip to the decimal string of GDrip without trailing zeros, such as "1.5".
+func FormatGDrip(drip *big.Int) string {
+	return formatUnit(drip, gDripDecimal)
+}
+
+// parseUnit parses the decimal string to integer in the minimum unit, which is 10^-decimals of the string unit.
+func parseUnit(value string, decimals int) (*big.Int, error) {
+	s := strings.TrimSpace(value)
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 || (parts[0] == "" && (len(parts) == 1 || parts[1] == "")) {
+		return nil, fmt.Errorf("invalid amount %q", value)
+	}
+
+	integer, fraction := parts[0], ""
+	if len(parts) == 2 {
+		fraction = strings.TrimRight(parts[1], "0")
+	}
+	if len(fraction) > decimals {
+		return nil, fmt.Errorf("amount %q has more than %v decimals", value, decimals)
+	}
+
+	digits := integer + fraction + strings.Repeat("0", decimals-len(fraction))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid amount %q", value)
+		}
+	}
+
+	result, _ := new(big.Int).SetString(digits, 10)
+	if result.Cmp(constants.MaxUint256) > 0 {
+		return nil, fmt.Errorf("amount %q overflows uint256", value)
+	}
+
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// formatUnit formats the integer in the minimum unit to the decimal string with decimals.
+func formatUnit(value *big.Int, decimals int) string {
+	if value == nil {
+		return "0"
+	}
+
+	digits := new(big.Int).Abs(value).String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	integer, fraction := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+
+	result := integer
+	if fraction != "" {
+		result += "." + fraction
+	}
+	if value.Sign() < 0 {
+		result = "-" + result
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseCFX(t *testing.T) {
+	maxUint256, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+
+	table := []struct {
+		input  string
+		expect string
+	}{
+		{"0", "0"},
+		{"1", "1000000000000000000"},
+		{"1.5", "1500000000000000000"},
+		{".5", "500000000000000000"},
+		{"2.", "2000000000000000000"},
+		{"0.000000000000000001", "1"},
+		{"0.0000000000000000010", "1"},
+		{"-1.25", "-1250000000000000000"},
+		{" 100 ", "100000000000000000000"},
+		{"115792089237316195423570985008687907853269984665640564039457.584007913129639935", maxUint256.String()},
+	}
+	for _, v := range table {
+		actual, err := ParseCFX(v.input)
+		if err != nil {
+			t.Errorf("Test ParseCFX %q failed, error: %v", v.input, err)
+			continue
+		}
+		if actual.String() != v.expect {
+			t.Errorf("Test ParseCFX %q failed, expect %v, actual %v", v.input, v.expect, actual)
+		}
+	}
+
+	invalids := []string{
+		"",
+		".",
+		"-",
+		"1.2.3",
+		"abc",
+		"1e18",
+		"+1",
+		"0.0000000000000000001",
+		"115792089237316195423570985008687907853269984665640564039457.584007913129639936",
+	}
+	for _, v := range invalids {
+		if actual, err := ParseCFX(v); err == nil {
+			t.Errorf("Test ParseCFX %q failed, expect error, actual %v", v, actual)
+		}
+	}
+}
+
+func TestFormatCFX(t *testing.T) {
+	table := []struct {
+		input  string
+		expect string
+	}{
+		{"0", "0"},
+		{"1", "0.000000000000000001"},
+		{"1500000000000000000", "1.5"},
+		{"1000000000000000000", "1"},
+		{"123456789000000000000", "123.456789"},
+		{"-1250000000000000000", "-1.25"},
+	}
+	for _, v := range table {
+		input, _ := new(big.Int).SetString(v.input, 10)
+		if actual := FormatCFX(input); actual != v.expect {
+			t.Errorf("Test FormatCFX %v failed, expect %v, actual %v", v.input, v.expect, actual)
+		}
+
+		// round trip
+		parsed, err := ParseCFX(v.expect)
+		if err != nil || parsed.Cmp(input) != 0 {
+			t.Errorf("Test ParseCFX of FormatCFX %v failed, actual %v, error %v", v.input, parsed, err)
+		}
+	}
+
+	if actual := FormatCFX(nil); actual != "0" {
+		t.Errorf("Test FormatCFX nil failed, expect 0, actual %v", actual)
+	}
+}
+
+func TestGDrip(t *testing.T) {
+	drip, err := ParseGDrip("1.5")
+	if err != nil || drip.String() != "1500000000" {
+		t.Errorf("Test ParseGDrip failed, expect 1500000000, actual %v, error %v", drip, err)
+	}
+
+	if _, err := ParseGDrip("0.0000000001"); err == nil {
+		t.Error("Test ParseGDrip with 10 decimals failed, expect error")
+	}
+
+	if actual := FormatGDrip(big.NewInt(1000000001)); actual != "1.000000001" {
+		t.Errorf("Test FormatGDrip failed, expect 1.000000001, actual %v", actual)
+	}
+}
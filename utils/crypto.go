@@ -74,3 +74,34 @@ func ToCfxGeneralAddress(address common.Address) types.Address {
 	address[0] = (address[0] & 0x0f) | 0x10
 	return types.Address(hexutil.Encode(address.Bytes()))
 }
+
+// PersonalMessageHash calculates the hash of message for personal sign, that is
+// keccak256("\x19Conflux Signed Message:\n" + len(message) + message)
+func PersonalMessageHash(message []byte) []byte {
+	prefix := fmt.Sprintf("\x19Conflux Signed Message:\n%d", len(message))
+	return crypto.Keccak256([]byte(prefix), message)
+}
+
+// EcRecover recovers the address which signed the message by personal sign,
+// sig is in the [R || S || V] format where V is 0 or 1, and 27 or 28 is also accepted.
+func EcRecover(message []byte, sig []byte) (types.Address, error) {
+	if len(sig) != 65 {
+		return "", fmt.Errorf("signature must be 65 bytes long, got %v", len(sig))
+	}
+
+	_sig := make([]byte, 65)
+	copy(_sig, sig)
+	if _sig[64] >= 27 {
+		_sig[64] -= 27
+	}
+	if _sig[64] != 0 && _sig[64] != 1 {
+		return "", fmt.Errorf("invalid recovery id %v of signature", sig[64])
+	}
+
+	pubKey, err := crypto.SigToPub(PersonalMessageHash(message), _sig)
+	if err != nil {
+		msg := fmt.Sprintf("recover public key from signature %x error", sig)
+		return "", types.WrapError(err, msg)
+	}
+	return ToCfxGeneralAddress(crypto.PubkeyToAddress(*pubKey)), nil
+}
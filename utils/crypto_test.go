@@ -51,3 +51,39 @@ func TestKeccak256(t *testing.T) {
 		t.Errorf("Test Keccak256 failed, expect %+v, actual %+v", expect, actual)
 	}
 }
+
+func TestEcRecover(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyStr[2:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := []byte("hello conflux")
+	sig, err := crypto.Sign(PersonalMessageHash(message), privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := EcRecover(message, sig)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(address) != addressStr {
+		t.Errorf("Test EcRecover failed, expect %v, actual %v", addressStr, address)
+	}
+
+	// V of 27 or 28 is also accepted
+	sig[64] += 27
+	if address, _ = EcRecover(message, sig); string(address) != addressStr {
+		t.Errorf("Test EcRecover with V + 27 failed, expect %v, actual %v", addressStr, address)
+	}
+
+	// signature of another message recovers another address
+	if address, _ = EcRecover([]byte("hello"), sig); string(address) == addressStr {
+		t.Errorf("Test EcRecover with tampered message failed, recovered the signer %v", address)
+	}
+
+	if _, err = EcRecover(message, sig[:64]); err == nil {
+		t.Error("Test EcRecover with short signature failed, expect error")
+	}
+}